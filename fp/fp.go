@@ -8,6 +8,8 @@
 //	)
 package fp
 
+import "sync"
+
 // Identity returns the supplied value unchanged.
 //
 // Example:
@@ -38,6 +40,84 @@ func Constant[T any](v T) func() T {
 	}
 }
 
+// ConstFn returns a function that ignores its input and always returns v.
+//
+// Example:
+//
+//	zeroes := seq.Map(ids, ConstFn[int](0))
+func ConstFn[A any, B any](v B) func(A) B {
+	return func(A) B {
+		return v
+	}
+}
+
+// Always returns a predicate that accepts every value.
+//
+// Example:
+//
+//	all := seq.Filter(users, Always[User]())
+func Always[T any]() func(T) bool {
+	return func(T) bool {
+		return true
+	}
+}
+
+// Never returns a predicate that rejects every value.
+//
+// Example:
+//
+//	none := seq.Filter(users, Never[User]())
+func Never[T any]() func(T) bool {
+	return func(T) bool {
+		return false
+	}
+}
+
+// Not negates predicate.
+//
+// Example:
+//
+//	inactive := seq.Filter(users, Not(isActive))
+func Not[T any](predicate func(T) bool) func(T) bool {
+	return func(v T) bool {
+		return !predicate(v)
+	}
+}
+
+// And returns a predicate that holds when every predicate holds, stopping at
+// the first failure. Without predicates it accepts every value.
+//
+// Example:
+//
+//	eligible := seq.Filter(users, And(isActive, isAdult))
+func And[T any](predicates ...func(T) bool) func(T) bool {
+	return func(v T) bool {
+		for _, predicate := range predicates {
+			if !predicate(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a predicate that holds when any predicate holds, stopping at the
+// first success. Without predicates it rejects every value.
+//
+// Example:
+//
+//	privileged := seq.Filter(users, Or(isAdmin, isOwner))
+func Or[T any](predicates ...func(T) bool) func(T) bool {
+	return func(v T) bool {
+		for _, predicate := range predicates {
+			if predicate(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // Maybe selects which function to evaluate based on cond, mirroring a ternary
 // operator while preserving laziness so only the chosen branch runs.
 //
@@ -77,6 +157,27 @@ func Pipe[T any](value T, fns ...func(T) T) T {
 	return result
 }
 
+// Pipe2 applies f then g to value, allowing each stage to change type.
+//
+// Example:
+//
+//	long := Pipe2("gopher",
+//		func(s string) int { return len(s) },
+//		func(n int) bool { return n > 3 },
+//	)
+func Pipe2[A any, B any, C any](value A, f func(A) B, g func(B) C) C {
+	return g(f(value))
+}
+
+// Pipe3 applies f, g, then h to value, allowing each stage to change type.
+//
+// Example:
+//
+//	label := Pipe3(" 42 ", strings.TrimSpace, parseInt, formatLabel)
+func Pipe3[A any, B any, C any, D any](value A, f func(A) B, g func(B) C, h func(C) D) D {
+	return h(g(f(value)))
+}
+
 // Compose composes functions in right-to-left order.
 //
 // Example:
@@ -96,6 +197,33 @@ func Compose[T any](fns ...func(T) T) func(T) T {
 	}
 }
 
+// Compose2 returns a function that applies f and then g. Like Compose, the
+// arguments are listed right-to-left: the function applied last comes first.
+//
+// Example:
+//
+//	isLong := Compose2(
+//		func(n int) bool { return n > 3 },
+//		func(s string) int { return len(s) },
+//	)
+func Compose2[A any, B any, C any](g func(B) C, f func(A) B) func(A) C {
+	return func(value A) C {
+		return g(f(value))
+	}
+}
+
+// Compose3 returns a function that applies f, then g, then h. The arguments
+// are listed right-to-left: h runs last but is passed first.
+//
+// Example:
+//
+//	label := Compose3(formatLabel, parseInt, strings.TrimSpace)
+func Compose3[A any, B any, C any, D any](h func(C) D, g func(B) C, f func(A) B) func(A) D {
+	return func(value A) D {
+		return h(g(f(value)))
+	}
+}
+
 // Curry converts a binary function into its curried form.
 //
 // Example:
@@ -111,3 +239,65 @@ func Curry[A any, B any, C any](fn func(A, B) C) func(A) func(B) C {
 		}
 	}
 }
+
+// Curry3 converts a ternary function into its curried form.
+//
+// Example:
+//
+//	clamp := Curry3(func(lo, hi, v int) int { return max(lo, min(hi, v)) })
+//	percent := clamp(0)(100)
+func Curry3[A any, B any, C any, D any](fn func(A, B, C) D) func(A) func(B) func(C) D {
+	return func(a A) func(B) func(C) D {
+		return func(b B) func(C) D {
+			return func(c C) D {
+				return fn(a, b, c)
+			}
+		}
+	}
+}
+
+// Uncurry converts a curried binary function back into a regular one. It is
+// the inverse of Curry.
+//
+// Example:
+//
+//	add := Uncurry(func(a int) func(int) int {
+//		return func(b int) int { return a + b }
+//	})
+func Uncurry[A any, B any, C any](fn func(A) func(B) C) func(A, B) C {
+	return func(a A, b B) C {
+		return fn(a)(b)
+	}
+}
+
+// Flip swaps the argument order of a binary function.
+//
+// Example:
+//
+//	trimPrefix := Flip(strings.TrimPrefix)
+//	value := trimPrefix("v", "v1.2.0") // "1.2.0"
+func Flip[A any, B any, C any](fn func(A, B) C) func(B, A) C {
+	return func(b B, a A) C {
+		return fn(a, b)
+	}
+}
+
+// Once returns a function that evaluates fn on the first call and returns the
+// cached result afterwards. It is safe for concurrent use.
+//
+// Example:
+//
+//	loadConfig := Once(func() Config { return parseConfig("app.yaml") })
+func Once[T any](fn func() T) func() T {
+	return sync.OnceValue(fn)
+}
+
+// OnceErr is like Once for fallible initializers: both the value and the error
+// of the first call are cached.
+//
+// Example:
+//
+//	connect := OnceErr(func() (*sql.DB, error) { return sql.Open("pgx", dsn) })
+func OnceErr[T any](fn func() (T, error)) func() (T, error) {
+	return sync.OnceValues(fn)
+}
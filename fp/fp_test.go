@@ -1,9 +1,15 @@
 package fp_test
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/charmingruby/fgp/fp"
+	"github.com/charmingruby/fgp/seq"
 )
 
 func TestPipeComposeCurry(t *testing.T) {
@@ -62,3 +68,137 @@ func TestMaybe(t *testing.T) {
 		t.Fatalf("unexpected branch counts after false path")
 	}
 }
+
+func TestConstantPredicates(t *testing.T) {
+	values := []int{1, 2, 3}
+	if got := seq.Filter(values, fp.Always[int]()); len(got) != 3 {
+		t.Fatalf("always should keep every value, got %v", got)
+	}
+	if got := seq.Filter(values, fp.Never[int]()); len(got) != 0 {
+		t.Fatalf("never should drop every value, got %v", got)
+	}
+	labels := seq.Map(values, fp.ConstFn[int]("x"))
+	for _, label := range labels {
+		if label != "x" {
+			t.Fatalf("unexpected constant output %v", labels)
+		}
+	}
+}
+
+func TestPipe2Pipe3(t *testing.T) {
+	long := fp.Pipe2("gopher", func(s string) int { return len(s) }, func(n int) bool { return n > 3 })
+	if !long {
+		t.Fatalf("expected pipe2 to report long string")
+	}
+	label := fp.Pipe3(" gopher ", strings.TrimSpace,
+		func(s string) int { return len(s) },
+		func(n int) string { return "len=" + strconv.Itoa(n) },
+	)
+	if label != "len=6" {
+		t.Fatalf("unexpected pipe3 result %q", label)
+	}
+}
+
+func TestCompose2Compose3(t *testing.T) {
+	isLong := fp.Compose2(func(n int) bool { return n > 3 }, func(s string) int { return len(s) })
+	if !isLong("gopher") || isLong("go") {
+		t.Fatalf("unexpected compose2 results")
+	}
+	label := fp.Compose3(
+		func(n int) string { return "len=" + strconv.Itoa(n) },
+		func(s string) int { return len(s) },
+		strings.TrimSpace,
+	)
+	if got := label(" gopher "); got != "len=6" {
+		t.Fatalf("expected right-to-left application, got %q", got)
+	}
+}
+
+func TestFlipUncurryCurry3(t *testing.T) {
+	sub := func(a, b int) int { return a - b }
+	if fp.Flip(sub)(2, 10) != 8 {
+		t.Fatalf("unexpected flip result")
+	}
+	if fp.Uncurry(fp.Curry(sub))(10, 2) != 8 {
+		t.Fatalf("expected uncurry to invert curry")
+	}
+	clamp := fp.Curry3(func(lo, hi, v int) int { return max(lo, min(hi, v)) })
+	percent := clamp(0)(100)
+	if percent(150) != 100 || percent(-5) != 0 || percent(42) != 42 {
+		t.Fatalf("unexpected curry3 results")
+	}
+}
+
+func TestOnce(t *testing.T) {
+	var calls atomic.Int32
+	get := fp.Once(func() int { return int(calls.Add(1)) })
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if get() != 1 {
+				t.Errorf("expected cached value")
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Fatalf("expected single evaluation, got %d", calls.Load())
+	}
+	errBoom := errors.New("boom")
+	failing := 0
+	load := fp.OnceErr(func() (string, error) {
+		failing++
+		return "", errBoom
+	})
+	for range 2 {
+		if _, err := load(); !errors.Is(err, errBoom) {
+			t.Fatalf("expected cached error, got %v", err)
+		}
+	}
+	if failing != 1 {
+		t.Fatalf("expected fallible initializer to run once, got %d", failing)
+	}
+}
+
+func TestPredicateCombinators(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	positive := func(n int) bool { return n > 0 }
+	in := []int{-2, -1, 0, 1, 2, 3, 4}
+	if got := seq.Filter(in, fp.Not(even)); len(got) != 3 {
+		t.Fatalf("unexpected not result %v", got)
+	}
+	if got := seq.Filter(in, fp.And(even, positive)); len(got) != 2 || got[0] != 2 {
+		t.Fatalf("unexpected and result %v", got)
+	}
+	if got := seq.Filter(in, fp.Or(even, positive)); len(got) != 6 {
+		t.Fatalf("unexpected or result %v", got)
+	}
+	if !seq.All(in, fp.And[int]()) || seq.Any(in, fp.Or[int]()) {
+		t.Fatalf("expected empty And to accept and empty Or to reject")
+	}
+}
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	base := fp.NewLazy(func() int {
+		calls.Add(1)
+		return 21
+	})
+	doubled := fp.Map(base, func(n int) int { return n * 2 })
+	label := fp.Map(doubled, strconv.Itoa)
+	if calls.Load() != 0 {
+		t.Fatalf("expected no evaluation before Force")
+	}
+	if label.Force() != "42" || doubled.Force() != 42 || base.Force() != 21 {
+		t.Fatalf("unexpected forced values")
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single evaluation, got %d", calls.Load())
+	}
+	var zero fp.Lazy[string]
+	if zero.Force() != "" {
+		t.Fatalf("expected zero Lazy to yield zero value")
+	}
+}
@@ -0,0 +1,49 @@
+package fp
+
+import "sync"
+
+// Lazy defers a computation until it is first forced and caches the result.
+// Copies of a Lazy share the same cached value.
+//
+// Example:
+//
+//	index := NewLazy(func() map[string]int { return buildIndex(rows) })
+type Lazy[T any] struct {
+	force func() T
+}
+
+// NewLazy wraps fn so it runs at most once, on the first call to Force. It is
+// safe for concurrent use.
+//
+// Example:
+//
+//	cfg := NewLazy(loadConfig)
+func NewLazy[T any](fn func() T) Lazy[T] {
+	return Lazy[T]{force: sync.OnceValue(fn)}
+}
+
+// Force evaluates the computation on first use and returns the cached value
+// afterwards. The zero Lazy yields the zero value of T.
+//
+// Example:
+//
+//	value := cfg.Force()
+func (l Lazy[T]) Force() T {
+	if l.force == nil {
+		var zero T
+		return zero
+	}
+	return l.force()
+}
+
+// Map derives a Lazy that applies fn to the value of l without forcing either
+// until the result is forced.
+//
+// Example:
+//
+//	port := Map(cfg, func(c Config) int { return c.Port })
+func Map[T any, U any](l Lazy[T], fn func(T) U) Lazy[U] {
+	return NewLazy(func() U {
+		return fn(l.Force())
+	})
+}
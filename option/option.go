@@ -12,6 +12,7 @@ package option
 import (
 	"errors"
 	"fmt"
+	"iter"
 
 	"github.com/charmingruby/fgp/result"
 )
@@ -216,6 +217,22 @@ func (o Option[T]) ToPtr() *T {
 	return &value
 }
 
+// Iter exposes the Option as a range-over-func sequence that yields the value
+// once when Some and nothing when None.
+//
+// Example:
+//
+//	for user := range lookupUser(id).Iter() {
+//		notify(user)
+//	}
+func (o Option[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.ok {
+			yield(o.value)
+		}
+	}
+}
+
 // Filter keeps the value when predicate returns true, otherwise it becomes None.
 //
 // Example:
@@ -257,6 +274,24 @@ func Map[T any, U any](o Option[T], fn func(T) U) Option[U] {
 	return None[U]()
 }
 
+// MapSafe behaves like Map but recovers a panicking fn by returning None,
+// treating the failed transform as "no value". None inputs never call fn.
+//
+// Example:
+//
+//	parsed := MapSafe(rawPayload, decodeUntrusted)
+func MapSafe[T any, U any](o Option[T], fn func(T) U) (out Option[U]) { //nolint:nonamedreturns // defer rewrites result on panic
+	if !o.ok {
+		return None[U]()
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			out = None[U]()
+		}
+	}()
+	return Some(fn(o.value))
+}
+
 // FlatMap chains the Option with another Option-valued function.
 //
 // Example:
@@ -269,6 +304,21 @@ func FlatMap[T any, U any](o Option[T], fn func(T) Option[U]) Option[U] {
 	return None[U]()
 }
 
+// Chain2 combines two Options with an Option-returning combiner, allowing fn to
+// reject the pair. It returns None when either input is None or fn rejects.
+//
+// Example:
+//
+//	session := Chain2(userOpt, tokenOpt, func(u User, token string) Option[Session] {
+//		return lookupSession(u.ID, token)
+//	})
+func Chain2[A any, B any, C any](a Option[A], b Option[B], fn func(A, B) Option[C]) Option[C] {
+	if a.ok && b.ok {
+		return fn(a.value, b.value)
+	}
+	return None[C]()
+}
+
 // Tap executes fn when the Option is Some and always returns the original Option.
 //
 // Example:
@@ -344,6 +394,26 @@ func Sequence[T any](items []Option[T]) Option[[]T] {
 	return Some(values)
 }
 
+// SequenceChan drains ch until it closes, returning Some with every value when
+// all received Options are Some. It stops draining and returns None as soon as
+// a None arrives, leaving any remaining values in the channel.
+//
+// Example:
+//
+//	lookups := make(chan option.Option[User])
+//	go streamLookups(ids, lookups)
+//	users := SequenceChan(lookups)
+func SequenceChan[T any](ch <-chan Option[T]) Option[[]T] {
+	values := []T{}
+	for item := range ch {
+		if !item.ok {
+			return None[[]T]()
+		}
+		values = append(values, item.value)
+	}
+	return Some(values)
+}
+
 // ToResult converts an Option into a Result, producing errFactory() when the
 // Option is None. If errFactory returns nil the function wraps a descriptive
 // error to avoid silent failures.
@@ -366,6 +436,38 @@ func (o Option[T]) ToResult(errFactory func() error) result.Result[T] {
 	return result.Err[T](err)
 }
 
+// OrElseResult returns Ok with the value when the Option is Some and otherwise
+// delegates to fn for a fallible fallback.
+//
+// Example:
+//
+//	user := OrElseResult(cache.Get(id), func() result.Result[User] {
+//		return result.FromTuple(db.LoadUser(ctx, id))
+//	})
+func OrElseResult[T any](o Option[T], fn func() result.Result[T]) result.Result[T] {
+	if o.ok {
+		return result.Ok(o.value)
+	}
+	return fn()
+}
+
+// OkOption converts a Result into an Option, returning Some with the value on
+// success and None on failure. The error is intentionally dropped; reach for
+// result.Fold when the failure needs handling. It lives in option rather than
+// as a Result method so result never imports option.
+//
+// Example:
+//
+//	cached := OkOption(cache.Lookup(key))
+//	value := cached.GetOrElse(defaultValue)
+func OkOption[T any](r result.Result[T]) Option[T] {
+	value, err := r.Unwrap()
+	if err != nil {
+		return None[T]()
+	}
+	return Some(value)
+}
+
 // String implements fmt.Stringer for debugging. It is not intended for
 // serialization and keeps implementation reflection-free.
 //
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/charmingruby/fgp/option"
+	"github.com/charmingruby/fgp/result"
 )
 
 func TestSomeNilBehavior(t *testing.T) {
@@ -130,3 +131,120 @@ func TestOptionInterop(t *testing.T) {
 		t.Fatalf("expected none from ok=false")
 	}
 }
+
+func TestOptionIter(t *testing.T) {
+	calls := 0
+	for range option.None[int]().Iter() {
+		calls++
+	}
+	if calls != 0 {
+		t.Fatalf("expected none to yield nothing, got %d", calls)
+	}
+	var got []int
+	for v := range option.Some(7).Iter() {
+		got = append(got, v)
+		break
+	}
+	if len(got) != 1 || got[0] != 7 {
+		t.Fatalf("unexpected iter output %v", got)
+	}
+}
+
+func TestOkOption(t *testing.T) {
+	some := option.OkOption(result.Ok(3))
+	if value, ok := some.Get(); !ok || value != 3 {
+		t.Fatalf("expected Some(3), got %v", some)
+	}
+	none := option.OkOption(result.Err[int](errors.New("boom")))
+	if none.IsSome() {
+		t.Fatalf("expected none for err result")
+	}
+}
+
+func TestOptionChain2(t *testing.T) {
+	divide := func(a, b int) option.Option[int] {
+		if b == 0 {
+			return option.None[int]()
+		}
+		return option.Some(a / b)
+	}
+	if got := option.Chain2(option.Some(6), option.Some(3), divide); got.GetOrElse(0) != 2 {
+		t.Fatalf("expected accepted pair, got %v", got)
+	}
+	if got := option.Chain2(option.Some(6), option.Some(0), divide); got.IsSome() {
+		t.Fatalf("expected combiner rejection, got %v", got)
+	}
+	calls := 0
+	absent := option.Chain2(option.None[int](), option.Some(1), func(a, b int) option.Option[int] {
+		calls++
+		return option.Some(a + b)
+	})
+	if absent.IsSome() || calls != 0 {
+		t.Fatalf("expected none without calling combiner")
+	}
+}
+
+func TestOrElseResult(t *testing.T) {
+	fallbackCalls := 0
+	fallback := func() result.Result[int] {
+		fallbackCalls++
+		return result.Ok(2)
+	}
+	if got := option.OrElseResult(option.Some(1), fallback); got.UnwrapOr(0) != 1 || fallbackCalls != 0 {
+		t.Fatalf("expected some value without fallback, got %v", got)
+	}
+	if got := option.OrElseResult(option.None[int](), fallback); got.UnwrapOr(0) != 2 || fallbackCalls != 1 {
+		t.Fatalf("expected fallback value, got %v", got)
+	}
+	boom := errors.New("boom")
+	got := option.OrElseResult(option.None[int](), func() result.Result[int] { return result.Err[int](boom) })
+	if !errors.Is(got.Err(), boom) {
+		t.Fatalf("expected fallback error, got %v", got.Err())
+	}
+}
+
+func TestOptionMapSafe(t *testing.T) {
+	if got := option.MapSafe(option.Some(4), func(v int) int { return v * 2 }); got.GetOrElse(0) != 8 {
+		t.Fatalf("unexpected mapped value %v", got)
+	}
+	panicking := option.MapSafe(option.Some(0), func(v int) int {
+		if v == 0 {
+			panic("division by zero")
+		}
+		return 10 / v
+	})
+	if panicking.IsSome() {
+		t.Fatalf("expected panic to yield none, got %v", panicking)
+	}
+	calls := 0
+	none := option.MapSafe(option.None[int](), func(v int) int {
+		calls++
+		return v
+	})
+	if none.IsSome() || calls != 0 {
+		t.Fatalf("expected none input to skip fn")
+	}
+}
+
+func TestSequenceChan(t *testing.T) {
+	all := make(chan option.Option[int], 3)
+	all <- option.Some(1)
+	all <- option.Some(2)
+	all <- option.Some(3)
+	close(all)
+	values, ok := option.SequenceChan(all).Get()
+	if !ok || len(values) != 3 || values[2] != 3 {
+		t.Fatalf("unexpected sequenced values %v", values)
+	}
+	mixed := make(chan option.Option[int], 3)
+	mixed <- option.Some(1)
+	mixed <- option.None[int]()
+	mixed <- option.Some(3)
+	close(mixed)
+	if option.SequenceChan(mixed).IsSome() {
+		t.Fatalf("expected none when stream contains none")
+	}
+	if rest := len(mixed); rest != 1 {
+		t.Fatalf("expected draining to stop at none, %d left", rest)
+	}
+}
@@ -10,7 +10,13 @@
 // transformations predictable even across retries and RPC boundaries.
 package result
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/charmingruby/fgp/fp"
+)
 
 // Result represents the outcome of a computation that may succeed with a value
 // or fail with an error. It never panics except in Unsafe helpers.
@@ -23,7 +29,7 @@ import "errors"
 //		log.Fatal(err)
 //	}
 //	fmt.Println(value)
-type Result[T any] struct {
+type Result[T any] struct { //nolint:recvcheck // pointer receiver needed by UnmarshalJSON only
 	value T
 	err   error
 }
@@ -136,6 +142,18 @@ func (r Result[T]) ToTuple() (T, error) {
 	return r.value, r.err
 }
 
+// ToError flattens a value-less Result into a plain error, returning nil on
+// success so effectful checks plug into idiomatic if err != nil flows.
+//
+// Example:
+//
+//	if err := result.ToError(validateQuota(account)); err != nil {
+//		return err
+//	}
+func ToError(r Result[fp.Unit]) error {
+	return r.err
+}
+
 // UnwrapOr returns the value when ok, otherwise returns fallback.
 //
 // Example:
@@ -162,6 +180,91 @@ func (r Result[T]) UnwrapOrElse(fn func(error) T) T {
 	return fn(r.err)
 }
 
+// OrElse returns the Result itself when Ok, otherwise returns other.
+//
+// Example:
+//
+//	cfg := loadFromEnv().OrElse(result.Ok(defaultConfig))
+func (r Result[T]) OrElse(other Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return other
+}
+
+// OrElseFunc behaves like OrElse but lazily builds the replacement from the
+// original error.
+//
+// Example:
+//
+//	cfg := loadFromCache().OrElseFunc(func(err error) result.Result[Config] {
+//		log.Println("cache miss", err)
+//		return loadFromDisk()
+//	})
+func (r Result[T]) OrElseFunc(fn func(error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return fn(r.err)
+}
+
+type resultJSON struct {
+	Value json.RawMessage `json:"value,omitempty"`
+	Error string          `json:"error,omitempty"`
+	OK    bool            `json:"ok"`
+}
+
+// MarshalJSON encodes success as {"value":...,"ok":true} and failure as
+// {"error":"...","ok":false}. Only the error message crosses the boundary.
+//
+// Example:
+//
+//	payload, err := json.Marshal(result.Ok(42))
+//	// payload == {"value":42,"ok":true}
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.err != nil {
+		return json.Marshal(resultJSON{OK: false, Error: r.err.Error()})
+	}
+	value, err := json.Marshal(r.value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resultJSON{OK: true, Value: value})
+}
+
+// UnmarshalJSON decodes the representation produced by MarshalJSON. When ok is
+// true the value is decoded into T even if an error field is present; otherwise
+// the error message is rebuilt with errors.New.
+//
+// Example:
+//
+//	var res result.Result[int]
+//	if err := json.Unmarshal(payload, &res); err != nil {
+//		return err
+//	}
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var wire resultJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	if wire.OK {
+		var value T
+		if len(wire.Value) > 0 {
+			if err := json.Unmarshal(wire.Value, &value); err != nil {
+				return err
+			}
+		}
+		*r = Ok(value)
+		return nil
+	}
+	if wire.Error == "" {
+		*r = Err[T](errors.New("result: missing error in json"))
+		return nil
+	}
+	*r = Err[T](errors.New(wire.Error))
+	return nil
+}
+
 // Map transforms the value on success.
 //
 // Example:
@@ -174,6 +277,23 @@ func Map[T any, U any](r Result[T], fn func(T) U) Result[U] {
 	return Err[U](r.err)
 }
 
+// MapEach maps every element of an Ok slice with fn and propagates errors
+// unchanged.
+//
+// Example:
+//
+//	names := result.MapEach(loadUsers(), func(u User) string { return u.Name })
+func MapEach[T any, U any](r Result[[]T], fn func(T) U) Result[[]U] {
+	if r.err != nil {
+		return Err[[]U](r.err)
+	}
+	out := make([]U, len(r.value))
+	for i, v := range r.value {
+		out[i] = fn(v)
+	}
+	return Ok(out)
+}
+
 // FlatMap chains computations, propagating the first error.
 //
 // Example:
@@ -220,6 +340,26 @@ func MapErr[T any](r Result[T], fn func(error) error) Result[T] {
 	return Err[T](fn(r.err))
 }
 
+// BiMap transforms whichever side is present: onOk maps the value into U and
+// onErr rewrites the error. Only the matching branch runs, so the other may be
+// nil; a nil onErr keeps the original error.
+//
+// Example:
+//
+//	dto := result.BiMap(loadUser(id),
+//		func(err error) error { return fmt.Errorf("load user %d: %w", id, err) },
+//		func(u User) UserDTO { return toDTO(u) },
+//	)
+func BiMap[T any, U any](r Result[T], onErr func(error) error, onOk func(T) U) Result[U] {
+	if r.err == nil {
+		return Ok(onOk(r.value))
+	}
+	if onErr == nil {
+		return Err[U](r.err)
+	}
+	return Err[U](onErr(r.err))
+}
+
 // Recover converts an error Result into success using fn while keeping success
 // values untouched.
 //
@@ -235,6 +375,29 @@ func Recover[T any](r Result[T], fn func(error) T) Result[T] {
 	return Ok(fn(r.err))
 }
 
+// Filter keeps an Ok value when predicate passes and otherwise converts it into
+// Err(errFactory(value)). Existing errors are returned untouched. When
+// errFactory is nil or returns nil a descriptive error is used instead.
+//
+// Example:
+//
+//	port := result.Filter(parsePort(raw), func(p int) bool { return p > 0 && p < 65536 },
+//		func(p int) error { return fmt.Errorf("port %d out of range", p) },
+//	)
+func Filter[T any](r Result[T], predicate func(T) bool, errFactory func(T) error) Result[T] {
+	if r.err != nil || predicate(r.value) {
+		return r
+	}
+	var err error
+	if errFactory != nil {
+		err = errFactory(r.value)
+	}
+	if err == nil {
+		err = errors.New("result: predicate failed")
+	}
+	return Err[T](err)
+}
+
 // Fold collapses the Result into a single value.
 //
 // Example:
@@ -278,6 +441,54 @@ func TapErr[T any](r Result[T], fn func(error)) Result[T] {
 	return r
 }
 
+// Inspect behaves like Tap but tolerates a nil fn, making it safe to wire
+// optional observers such as loggers into a chain.
+//
+// Example:
+//
+//	res := result.Inspect(loadUser(id), logger.OnUser)
+func Inspect[T any](r Result[T], fn func(T)) Result[T] {
+	if fn == nil {
+		return r
+	}
+	return Tap(r, fn)
+}
+
+// InspectErr behaves like TapErr but tolerates a nil fn.
+//
+// Example:
+//
+//	res := result.InspectErr(loadUser(id), logger.OnError)
+func InspectErr[T any](r Result[T], fn func(error)) Result[T] {
+	if fn == nil {
+		return r
+	}
+	return TapErr(r, fn)
+}
+
+// ForEach walks results in order, calling onOk for successes and onErr for
+// failures. Either callback may be nil to skip that side.
+//
+// Example:
+//
+//	result.ForEach(outcomes,
+//		func(id int) { log.Println("imported", id) },
+//		func(err error) { log.Println("import failed", err) },
+//	)
+func ForEach[T any](results []Result[T], onOk func(T), onErr func(error)) {
+	for _, r := range results {
+		if r.err == nil {
+			if onOk != nil {
+				onOk(r.value)
+			}
+			continue
+		}
+		if onErr != nil {
+			onErr(r.err)
+		}
+	}
+}
+
 // Collect gathers the successful values from the provided Results, ignoring failures.
 // The returned slice never shares the backing array with inputs.
 //
@@ -318,6 +529,28 @@ func PartitionResults[T any](results []Result[T]) ([]T, []error) {
 	return values, errs
 }
 
+// Split partitions results like PartitionResults but pairs every value and
+// error with its original index so failures can be traced back to inputs.
+//
+// Example:
+//
+//	oks, errs := result.Split(rows)
+//	for _, failed := range errs {
+//		log.Printf("row %d: %v", failed.First, failed.Second)
+//	}
+func Split[T any](results []Result[T]) ([]Tuple2[int, T], []Tuple2[int, error]) {
+	oks := make([]Tuple2[int, T], 0, len(results))
+	errs := make([]Tuple2[int, error], 0, len(results))
+	for i, r := range results {
+		if r.err == nil {
+			oks = append(oks, Tuple2[int, T]{First: i, Second: r.value})
+			continue
+		}
+		errs = append(errs, Tuple2[int, error]{First: i, Second: r.err})
+	}
+	return oks, errs
+}
+
 // Zip2 combines two results into one containing a pair of values.
 //
 // Example:
@@ -351,6 +584,70 @@ func Zip3[A any, B any, C any](ra Result[A], rb Result[B], rc Result[C]) Result[
 	return Ok(Tuple3[A, B, C]{First: ra.value, Second: rb.value, Third: rc.value})
 }
 
+// Zip4 combines four results into one containing a quadruple of values,
+// returning the first error in argument order.
+//
+// Example:
+//
+//	combined := result.Zip4(loadUser(), loadProfile(), loadSettings(), loadBilling())
+func Zip4[A any, B any, C any, D any](
+	ra Result[A],
+	rb Result[B],
+	rc Result[C],
+	rd Result[D],
+) Result[Tuple4[A, B, C, D]] {
+	if ra.err != nil {
+		return Err[Tuple4[A, B, C, D]](ra.err)
+	}
+	if rb.err != nil {
+		return Err[Tuple4[A, B, C, D]](rb.err)
+	}
+	if rc.err != nil {
+		return Err[Tuple4[A, B, C, D]](rc.err)
+	}
+	if rd.err != nil {
+		return Err[Tuple4[A, B, C, D]](rd.err)
+	}
+	return Ok(Tuple4[A, B, C, D]{First: ra.value, Second: rb.value, Third: rc.value, Fourth: rd.value})
+}
+
+// Map2 combines two Results with fn, short-circuiting on the first error in
+// argument order. fn only runs when both Results are Ok.
+//
+// Example:
+//
+//	order := result.Map2(loadUser(), loadCart(), func(u User, c Cart) Order {
+//		return NewOrder(u, c)
+//	})
+func Map2[A any, B any, C any](ra Result[A], rb Result[B], fn func(A, B) C) Result[C] {
+	if ra.err != nil {
+		return Err[C](ra.err)
+	}
+	if rb.err != nil {
+		return Err[C](rb.err)
+	}
+	return Ok(fn(ra.value, rb.value))
+}
+
+// Map3 combines three Results with fn, short-circuiting on the first error in
+// argument order. fn only runs when all Results are Ok.
+//
+// Example:
+//
+//	page := result.Map3(loadUser(), loadProfile(), loadSettings(), renderPage)
+func Map3[A any, B any, C any, D any](ra Result[A], rb Result[B], rc Result[C], fn func(A, B, C) D) Result[D] {
+	if ra.err != nil {
+		return Err[D](ra.err)
+	}
+	if rb.err != nil {
+		return Err[D](rb.err)
+	}
+	if rc.err != nil {
+		return Err[D](rc.err)
+	}
+	return Ok(fn(ra.value, rb.value, rc.value))
+}
+
 // Sequence converts a slice of Results into a Result containing a slice of
 // values, failing fast on the first error.
 //
@@ -368,6 +665,54 @@ func Sequence[T any](results []Result[T]) Result[[]T] {
 	return Ok(values)
 }
 
+// TraverseIndexed behaves like Traverse but passes each item's index to fn and
+// annotates the first error as "index i: err" so positional context survives.
+// The original error stays reachable through errors.Is.
+//
+// Example:
+//
+//	res := result.TraverseIndexed(rows, func(i int, row Row) result.Result[Record] {
+//		return parseRow(row)
+//	})
+func TraverseIndexed[A any, B any](items []A, fn func(int, A) Result[B]) Result[[]B] {
+	values := make([]B, 0, len(items))
+	for i, item := range items {
+		res := fn(i, item)
+		if res.err != nil {
+			return Err[[]B](fmt.Errorf("index %d: %w", i, res.err))
+		}
+		values = append(values, res.value)
+	}
+	return Ok(values)
+}
+
+// SequenceAccumulate behaves like Sequence but reports every failure, joining
+// all errors in order with errors.Join. The value slice is only allocated when
+// every element succeeded.
+//
+// Example:
+//
+//	res := result.SequenceAccumulate(importRows(rows))
+//	if err := res.Err(); err != nil {
+//		return err // lists every failing row
+//	}
+func SequenceAccumulate[T any](results []Result[T]) Result[[]T] {
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+		}
+	}
+	if len(errs) > 0 {
+		return Err[[]T](errors.Join(errs...))
+	}
+	values := make([]T, len(results))
+	for i, r := range results {
+		values[i] = r.value
+	}
+	return Ok(values)
+}
+
 // Traverse maps input values to Results and sequences them.
 //
 // Example:
@@ -387,6 +732,27 @@ func Traverse[A any, B any](items []A, fn func(A) Result[B]) Result[[]B] {
 	return Ok(values)
 }
 
+// Zip2Slices pairs elements of as and bs up to the shorter length, combining
+// each pair with fn and failing fast on the first error.
+//
+// Example:
+//
+//	invoices := result.Zip2Slices(orders, payments, func(o Order, p Payment) result.Result[Invoice] {
+//		return buildInvoice(o, p)
+//	})
+func Zip2Slices[A any, B any, C any](as []A, bs []B, fn func(A, B) Result[C]) Result[[]C] {
+	limit := min(len(as), len(bs))
+	values := make([]C, 0, limit)
+	for i := range limit {
+		res := fn(as[i], bs[i])
+		if res.err != nil {
+			return Err[[]C](res.err)
+		}
+		values = append(values, res.value)
+	}
+	return Ok(values)
+}
+
 // Tuple2 represents a pair of values.
 //
 // Example:
@@ -407,3 +773,15 @@ type Tuple3[A any, B any, C any] struct {
 	Second B
 	Third  C
 }
+
+// Tuple4 represents four values.
+//
+// Example:
+//
+//	t := result.Tuple4[int, string, bool, float64]{First: 1, Second: "a", Third: true, Fourth: 2.5}
+type Tuple4[A any, B any, C any, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
@@ -1,9 +1,13 @@
 package result_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/charmingruby/fgp/fp"
 	"github.com/charmingruby/fgp/result"
 )
 
@@ -87,3 +91,274 @@ func TestTupleInterop(t *testing.T) {
 		t.Fatalf("expected error result")
 	}
 }
+
+func TestToError(t *testing.T) {
+	if err := result.ToError(result.Ok(fp.UnitValue)); err != nil {
+		t.Fatalf("expected nil error for ok, got %v", err)
+	}
+	boom := errors.New("boom")
+	if err := result.ToError(result.Err[fp.Unit](boom)); !errors.Is(err, boom) {
+		t.Fatalf("expected stored error, got %v", err)
+	}
+}
+
+func TestResultJSONRoundTrip(t *testing.T) {
+	payload, err := json.Marshal(result.Ok(42))
+	if err != nil || string(payload) != `{"value":42,"ok":true}` {
+		t.Fatalf("unexpected ok payload %s %v", payload, err)
+	}
+	var decoded result.Result[int]
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if decoded.IsErr() || decoded.UnwrapOr(0) != 42 {
+		t.Fatalf("unexpected decoded ok %v", decoded)
+	}
+
+	payload, err = json.Marshal(result.Err[int](errors.New("boom")))
+	if err != nil || string(payload) != `{"error":"boom","ok":false}` {
+		t.Fatalf("unexpected err payload %s %v", payload, err)
+	}
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if decoded.IsOk() || decoded.Err().Error() != "boom" {
+		t.Fatalf("unexpected decoded err %v", decoded.Err())
+	}
+}
+
+func TestResultJSONPrefersOk(t *testing.T) {
+	var decoded result.Result[string]
+	if err := json.Unmarshal([]byte(`{"ok":true,"value":"v","error":"ignored"}`), &decoded); err != nil {
+		t.Fatalf("unexpected unmarshal error: %v", err)
+	}
+	if decoded.IsErr() || decoded.UnwrapOr("") != "v" {
+		t.Fatalf("expected ok to win, got %v", decoded.Err())
+	}
+}
+
+func TestMap2AndMap3(t *testing.T) {
+	sum := result.Map2(result.Ok(1), result.Ok(2), func(a, b int) int { return a + b })
+	if sum.UnwrapOr(0) != 3 {
+		t.Fatalf("unexpected map2 value %v", sum)
+	}
+	first := errors.New("first")
+	second := errors.New("second")
+	calls := 0
+	failed := result.Map2(result.Err[int](first), result.Err[int](second), func(a, b int) int {
+		calls++
+		return a + b
+	})
+	if !errors.Is(failed.Err(), first) || calls != 0 {
+		t.Fatalf("expected first error without calling fn, got %v", failed.Err())
+	}
+	joined := result.Map3(result.Ok("a"), result.Ok("b"), result.Ok("c"), func(a, b, c string) string {
+		return a + b + c
+	})
+	if joined.UnwrapOr("") != "abc" {
+		t.Fatalf("unexpected map3 value %v", joined)
+	}
+	failed3 := result.Map3(result.Ok(1), result.Err[int](second), result.Ok(3), func(a, b, c int) int {
+		return a + b + c
+	})
+	if !errors.Is(failed3.Err(), second) {
+		t.Fatalf("expected second error, got %v", failed3.Err())
+	}
+}
+
+func TestFilter(t *testing.T) {
+	inRange := func(p int) bool { return p > 0 && p < 65536 }
+	outOfRange := errors.New("out of range")
+	factory := func(int) error { return outOfRange }
+	if kept := result.Filter(result.Ok(8080), inRange, factory); kept.UnwrapOr(0) != 8080 {
+		t.Fatalf("expected value kept, got %v", kept.Err())
+	}
+	if rejected := result.Filter(result.Ok(0), inRange, factory); !errors.Is(rejected.Err(), outOfRange) {
+		t.Fatalf("expected factory error, got %v", rejected.Err())
+	}
+	if rejected := result.Filter(result.Ok(0), inRange, nil); rejected.IsOk() {
+		t.Fatalf("expected default error when factory is nil")
+	}
+	boom := errors.New("boom")
+	if untouched := result.Filter(result.Err[int](boom), inRange, factory); !errors.Is(untouched.Err(), boom) {
+		t.Fatalf("expected original error, got %v", untouched.Err())
+	}
+}
+
+func TestSplitKeepsIndices(t *testing.T) {
+	boom := errors.New("boom")
+	results := []result.Result[string]{
+		result.Err[string](boom),
+		result.Ok("b"),
+		result.Ok("c"),
+		result.Err[string](boom),
+	}
+	oks, errs := result.Split(results)
+	wantOks := []result.Tuple2[int, string]{{First: 1, Second: "b"}, {First: 2, Second: "c"}}
+	if !reflect.DeepEqual(oks, wantOks) {
+		t.Fatalf("unexpected oks %v", oks)
+	}
+	if len(errs) != 2 || errs[0].First != 0 || errs[1].First != 3 || !errors.Is(errs[1].Second, boom) {
+		t.Fatalf("unexpected errs %v", errs)
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	primary := errors.New("primary down")
+	if got := result.Ok(1).OrElse(result.Ok(2)); got.UnwrapOr(0) != 1 {
+		t.Fatalf("expected primary value, got %v", got)
+	}
+	if got := result.Err[int](primary).OrElse(result.Ok(2)); got.UnwrapOr(0) != 2 {
+		t.Fatalf("expected fallback value, got %v", got)
+	}
+	var seen error
+	got := result.Err[int](primary).OrElseFunc(func(err error) result.Result[int] {
+		seen = err
+		return result.Ok(3)
+	})
+	if got.UnwrapOr(0) != 3 || !errors.Is(seen, primary) {
+		t.Fatalf("expected lazy fallback with original error, got %v %v", got, seen)
+	}
+	result.Ok(1).OrElseFunc(func(err error) result.Result[int] {
+		t.Fatalf("fallback should not run: %v", err)
+		return result.Ok(0)
+	})
+}
+
+func TestZip4(t *testing.T) {
+	zip := result.Zip4(result.Ok(1), result.Ok("b"), result.Ok(true), result.Ok(4.5))
+	want := result.Tuple4[int, string, bool, float64]{First: 1, Second: "b", Third: true, Fourth: 4.5}
+	if zip.IsErr() || zip.UnsafeUnwrap() != want {
+		t.Fatalf("unexpected zip4 output %v", zip)
+	}
+	second := errors.New("second")
+	third := errors.New("third")
+	failed := result.Zip4(result.Ok(1), result.Err[int](second), result.Err[int](third), result.Ok(4))
+	if !errors.Is(failed.Err(), second) {
+		t.Fatalf("expected second error to win, got %v", failed.Err())
+	}
+}
+
+func TestBiMap(t *testing.T) {
+	length := result.BiMap(result.Ok("abc"), nil, func(s string) int { return len(s) })
+	if length.UnwrapOr(0) != 3 {
+		t.Fatalf("unexpected bimap value %v", length)
+	}
+	boom := errors.New("boom")
+	wrapped := result.BiMap[string, int](result.Err[string](boom), func(err error) error {
+		return fmt.Errorf("wrapped: %w", err)
+	}, nil)
+	if !errors.Is(wrapped.Err(), boom) || wrapped.Err().Error() != "wrapped: boom" {
+		t.Fatalf("unexpected bimap error %v", wrapped.Err())
+	}
+	kept := result.BiMap[string, int](result.Err[string](boom), nil, nil)
+	if !errors.Is(kept.Err(), boom) {
+		t.Fatalf("expected original error with nil onErr, got %v", kept.Err())
+	}
+}
+
+func TestInspect(t *testing.T) {
+	var seen int
+	res := result.Inspect(result.Ok(4), func(v int) { seen = v })
+	if seen != 4 || res.UnwrapOr(0) != 4 {
+		t.Fatalf("expected inspect to observe value, got %d", seen)
+	}
+	boom := errors.New("boom")
+	var seenErr error
+	failed := result.InspectErr(result.Err[int](boom), func(err error) { seenErr = err })
+	if !errors.Is(seenErr, boom) || !errors.Is(failed.Err(), boom) {
+		t.Fatalf("expected inspectErr to observe error, got %v", seenErr)
+	}
+	if got := result.Inspect(result.Ok(1), nil); got.UnwrapOr(0) != 1 {
+		t.Fatalf("nil inspect should pass through")
+	}
+	if got := result.InspectErr(result.Err[int](boom), nil); !errors.Is(got.Err(), boom) {
+		t.Fatalf("nil inspectErr should pass through")
+	}
+}
+
+func TestSequenceAccumulate(t *testing.T) {
+	ok := result.SequenceAccumulate([]result.Result[int]{result.Ok(1), result.Ok(2)})
+	if !reflect.DeepEqual(ok.UnwrapOr(nil), []int{1, 2}) {
+		t.Fatalf("unexpected accumulated values %v", ok)
+	}
+	errA := errors.New("a")
+	errB := errors.New("b")
+	errC := errors.New("c")
+	failed := result.SequenceAccumulate([]result.Result[int]{
+		result.Err[int](errA),
+		result.Ok(2),
+		result.Err[int](errB),
+		result.Err[int](errC),
+	})
+	for _, want := range []error{errA, errB, errC} {
+		if !errors.Is(failed.Err(), want) {
+			t.Fatalf("expected joined error to contain %v, got %v", want, failed.Err())
+		}
+	}
+}
+
+func TestForEach(t *testing.T) {
+	boom := errors.New("boom")
+	results := []result.Result[int]{result.Ok(1), result.Err[int](boom), result.Ok(3)}
+	oks, errs := 0, 0
+	result.ForEach(results, func(int) { oks++ }, func(error) { errs++ })
+	if oks != 2 || errs != 1 {
+		t.Fatalf("unexpected callback counts ok=%d err=%d", oks, errs)
+	}
+	result.ForEach(results, nil, func(error) { errs++ })
+	if errs != 2 {
+		t.Fatalf("expected nil onOk to be skipped")
+	}
+}
+
+func TestZip2Slices(t *testing.T) {
+	add := func(a, b int) result.Result[int] { return result.Ok(a + b) }
+	sums := result.Zip2Slices([]int{1, 2, 3}, []int{10, 20}, add)
+	if !reflect.DeepEqual(sums.UnwrapOr(nil), []int{11, 22}) {
+		t.Fatalf("unexpected zipped values %v", sums)
+	}
+	boom := errors.New("boom")
+	calls := 0
+	failed := result.Zip2Slices([]int{1, 2, 3}, []int{1, 0, 1}, func(a, b int) result.Result[int] {
+		calls++
+		if b == 0 {
+			return result.Err[int](boom)
+		}
+		return result.Ok(a / b)
+	})
+	if !errors.Is(failed.Err(), boom) || calls != 2 {
+		t.Fatalf("expected short-circuit on second pair, got %v after %d calls", failed.Err(), calls)
+	}
+}
+
+func TestMapEach(t *testing.T) {
+	doubled := result.MapEach(result.Ok([]int{1, 2, 3}), func(v int) int { return v * 2 })
+	if !reflect.DeepEqual(doubled.UnwrapOr(nil), []int{2, 4, 6}) {
+		t.Fatalf("unexpected mapped slice %v", doubled)
+	}
+	boom := errors.New("boom")
+	failed := result.MapEach(result.Err[[]int](boom), func(v int) int { return v })
+	if !errors.Is(failed.Err(), boom) {
+		t.Fatalf("expected propagated error, got %v", failed.Err())
+	}
+}
+
+func TestTraverseIndexed(t *testing.T) {
+	ok := result.TraverseIndexed([]string{"a", "b"}, func(i int, s string) result.Result[string] {
+		return result.Ok(fmt.Sprintf("%d:%s", i, s))
+	})
+	if !reflect.DeepEqual(ok.UnwrapOr(nil), []string{"0:a", "1:b"}) {
+		t.Fatalf("unexpected indexed values %v", ok)
+	}
+	boom := errors.New("boom")
+	failed := result.TraverseIndexed([]int{1, 2, 3}, func(_ int, v int) result.Result[int] {
+		if v == 3 {
+			return result.Err[int](boom)
+		}
+		return result.Ok(v)
+	})
+	if !errors.Is(failed.Err(), boom) || failed.Err().Error() != "index 2: boom" {
+		t.Fatalf("expected index annotation, got %v", failed.Err())
+	}
+}
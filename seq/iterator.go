@@ -5,6 +5,8 @@
 //	values := seq.Map([]int{1, 2, 3}, func(n int) int { return n * 2 })
 package seq
 
+import "iter"
+
 // Iterator is a lazy, pull-based iterator.
 //
 // Example:
@@ -167,6 +169,38 @@ func Range(start, end int) Iterator[int] {
 	}
 }
 
+// RangeStep yields integers from start towards end (exclusive) moving by step.
+// Negative steps produce descending ranges. The iterator is empty when step is
+// zero or points away from end.
+//
+// Example:
+//
+//	it := RangeStep(5, 0, -2) // yields 5,3,1
+func RangeStep(start, end, step int) Iterator[int] {
+	if step == 0 || (step > 0 && start >= end) || (step < 0 && start <= end) {
+		return Iterator[int]{}
+	}
+	current := start
+	done := false
+	return Iterator[int]{
+		next: func() (int, bool) {
+			if done {
+				return 0, false
+			}
+			value := current
+			// Detect wraparound so ranges near the int limits still terminate.
+			next := current + step
+			if step > 0 {
+				done = next < current || next >= end
+			} else {
+				done = next > current || next <= end
+			}
+			current = next
+			return value, true
+		},
+	}
+}
+
 // Repeat creates an infinite iterator repeating value. Consumers should limit
 // it with Take/TakeWhile to avoid unbounded loops.
 //
@@ -181,6 +215,27 @@ func Repeat[T any](value T) Iterator[T] {
 	}
 }
 
+// Cycle yields the elements of values repeatedly, wrapping back to the start
+// after the last one. An empty slice yields an empty iterator. Like Repeat it
+// is infinite otherwise, so bound it with Take or ZipIter.
+//
+// Example:
+//
+//	workers := Cycle([]string{"w1", "w2"})
+func Cycle[T any](values []T) Iterator[T] {
+	if len(values) == 0 {
+		return Iterator[T]{}
+	}
+	idx := 0
+	return Iterator[T]{
+		next: func() (T, bool) {
+			v := values[idx]
+			idx = (idx + 1) % len(values)
+			return v, true
+		},
+	}
+}
+
 // Iterate repeatedly applies fn to state starting from seed.
 //
 // Example:
@@ -276,3 +331,413 @@ func ToSlice[T any](it Iterator[T]) []T {
 	}
 	return result
 }
+
+// ZipIter lazily pairs values from a and b, stopping as soon as either is
+// exhausted. Once a ends, b is not pulled again.
+//
+// Example:
+//
+//	pairs := ZipIter(Range(0, 3), FromSlice([]string{"x", "y"}))
+func ZipIter[A any, B any](a Iterator[A], b Iterator[B]) Iterator[Pair[A, B]] {
+	done := false
+	return Iterator[Pair[A, B]]{
+		next: func() (Pair[A, B], bool) {
+			if done {
+				return Pair[A, B]{}, false
+			}
+			left, ok := a.Next()
+			if !ok {
+				done = true
+				return Pair[A, B]{}, false
+			}
+			right, ok := b.Next()
+			if !ok {
+				done = true
+				return Pair[A, B]{}, false
+			}
+			return Pair[A, B]{First: left, Second: right}, true
+		},
+	}
+}
+
+// FlatMapIter lazily expands each value of it into the iterator returned by
+// fn, draining every inner iterator before pulling the next outer value.
+//
+// Example:
+//
+//	expanded := FlatMapIter(Range(1, 3), func(n int) Iterator[int] { return Range(0, n) })
+func FlatMapIter[A any, B any](it Iterator[A], fn func(A) Iterator[B]) Iterator[B] {
+	var inner Iterator[B]
+	return Iterator[B]{
+		next: func() (B, bool) {
+			for {
+				if v, ok := inner.Next(); ok {
+					return v, true
+				}
+				outer, ok := it.Next()
+				if !ok {
+					var zero B
+					return zero, false
+				}
+				inner = fn(outer)
+			}
+		},
+	}
+}
+
+// Chain lazily yields every value of each iterator in turn, only pulling from
+// the next iterator once the current one is exhausted. Without arguments it
+// returns an empty iterator.
+//
+// Example:
+//
+//	all := Chain(FromSlice([]int{1}), FromSlice([]int{2, 3}))
+func Chain[T any](iters ...Iterator[T]) Iterator[T] {
+	idx := 0
+	return Iterator[T]{
+		next: func() (T, bool) {
+			for idx < len(iters) {
+				if v, ok := iters[idx].Next(); ok {
+					return v, true
+				}
+				idx++
+			}
+			var zero T
+			return zero, false
+		},
+	}
+}
+
+// ConcatIter is the two-argument form of Chain.
+//
+// Example:
+//
+//	both := ConcatIter(FromSlice([]int{1}), FromSlice([]int{2}))
+func ConcatIter[T any](a, b Iterator[T]) Iterator[T] {
+	return Chain(a, b)
+}
+
+// FoldLeftIter drains it, folding each value into the accumulator.
+//
+// Example:
+//
+//	total := FoldLeftIter(Range(1, 4), 0, func(acc, v int) int { return acc + v })
+func FoldLeftIter[A any, B any](it Iterator[A], init B, fn func(B, A) B) B {
+	acc := init
+	for {
+		v, ok := it.Next()
+		if !ok {
+			return acc
+		}
+		acc = fn(acc, v)
+	}
+}
+
+// ReduceIter drains it, combining values with fn. It returns false when the
+// iterator is empty.
+//
+// Example:
+//
+//	maxValue, ok := ReduceIter(it, func(a, b int) int { return max(a, b) })
+func ReduceIter[T any](it Iterator[T], fn func(T, T) T) (T, bool) {
+	first, ok := it.Next()
+	if !ok {
+		return first, false
+	}
+	return FoldLeftIter(it, first, fn), true
+}
+
+// PeekableIterator wraps an Iterator with one element of lookahead.
+//
+// Example:
+//
+//	p := Peekable(FromSlice([]int{1, 2}))
+//	next, _ := p.Peek()
+type PeekableIterator[T any] struct {
+	it     Iterator[T]
+	peeked T
+	has    bool
+}
+
+// Peekable wraps it so the next value can be inspected without consuming it.
+// At most one element is buffered.
+//
+// Example:
+//
+//	p := Peekable(tokens)
+func Peekable[T any](it Iterator[T]) *PeekableIterator[T] {
+	return &PeekableIterator[T]{it: it}
+}
+
+// Peek returns the next value without advancing. Repeated calls return the
+// same value until Next is called.
+//
+// Example:
+//
+//	if tok, ok := p.Peek(); ok && tok == "(" {
+//		p.Next()
+//	}
+func (p *PeekableIterator[T]) Peek() (T, bool) {
+	if !p.has {
+		v, ok := p.it.Next()
+		if !ok {
+			return v, false
+		}
+		p.peeked, p.has = v, true
+	}
+	return p.peeked, true
+}
+
+// Next yields the buffered value if present, otherwise pulls from the
+// underlying iterator.
+//
+// Example:
+//
+//	value, ok := p.Next()
+func (p *PeekableIterator[T]) Next() (T, bool) {
+	if p.has {
+		v := p.peeked
+		var zero T
+		p.peeked, p.has = zero, false
+		return v, true
+	}
+	return p.it.Next()
+}
+
+// Tee splits it into n independent iterators that each yield the full
+// sequence. Values pulled by the fastest branch are buffered until the slowest
+// branch has consumed them, so memory grows with the gap between branches;
+// draining one branch completely buffers the whole sequence. The source must
+// not be consumed directly afterwards.
+//
+// Example:
+//
+//	branches := Tee(it, 2)
+//	first, second := branches[0], branches[1]
+func Tee[T any](it Iterator[T], n int) []Iterator[T] {
+	if n <= 0 {
+		return []Iterator[T]{}
+	}
+	var (
+		buf     []T
+		base    int
+		done    bool
+		offsets = make([]int, n)
+	)
+	trim := func() {
+		low := offsets[0]
+		for _, off := range offsets[1:] {
+			low = min(low, off)
+		}
+		if drop := low - base; drop > 0 {
+			clear(buf[:drop])
+			buf = buf[drop:]
+			base = low
+		}
+	}
+	branches := make([]Iterator[T], n)
+	for i := range branches {
+		branches[i] = Iterator[T]{
+			next: func() (T, bool) {
+				pos := offsets[i] - base
+				if pos >= len(buf) {
+					if done {
+						var zero T
+						return zero, false
+					}
+					v, ok := it.Next()
+					if !ok {
+						done = true
+						return v, false
+					}
+					buf = append(buf, v)
+				}
+				v := buf[pos]
+				offsets[i]++
+				trim()
+				return v, true
+			},
+		}
+	}
+	return branches
+}
+
+// ScanLeftIter lazily yields init followed by the running accumulation after
+// each element, mirroring ScanLeft.
+//
+// Example:
+//
+//	totals := Take(ScanLeftIter(Repeat(1), 0, func(acc, v int) int { return acc + v }), 4)
+func ScanLeftIter[A any, B any](it Iterator[A], init B, fn func(B, A) B) Iterator[B] {
+	acc := init
+	started := false
+	return Iterator[B]{
+		next: func() (B, bool) {
+			if !started {
+				started = true
+				return acc, true
+			}
+			v, ok := it.Next()
+			if !ok {
+				var zero B
+				return zero, false
+			}
+			acc = fn(acc, v)
+			return acc, true
+		},
+	}
+}
+
+// ChunkIter lazily groups values into slices of size elements; the last chunk
+// may be smaller. A non-positive size yields nothing.
+//
+// Example:
+//
+//	batches := ChunkIter(Iterate(0, func(n int) int { return n + 1 }), 100)
+func ChunkIter[T any](it Iterator[T], size int) Iterator[[]T] {
+	if size <= 0 {
+		return Iterator[[]T]{}
+	}
+	return Iterator[[]T]{
+		next: func() ([]T, bool) {
+			chunk := make([]T, 0, size)
+			for len(chunk) < size {
+				v, ok := it.Next()
+				if !ok {
+					break
+				}
+				chunk = append(chunk, v)
+			}
+			if len(chunk) == 0 {
+				return nil, false
+			}
+			return chunk, true
+		},
+	}
+}
+
+// WindowIter lazily yields sliding windows of size elements, pulling one new
+// source value per window after the first. Each window is a fresh copy. A
+// non-positive size, or a source shorter than size, yields nothing.
+//
+// Example:
+//
+//	pairs := WindowIter(FromSlice([]int{1, 2, 3}), 2) // [1,2], [2,3]
+func WindowIter[T any](it Iterator[T], size int) Iterator[[]T] {
+	if size <= 0 {
+		return Iterator[[]T]{}
+	}
+	var window []T
+	return Iterator[[]T]{
+		next: func() ([]T, bool) {
+			if window == nil {
+				window = make([]T, 0, size)
+				for len(window) < size {
+					v, ok := it.Next()
+					if !ok {
+						return nil, false
+					}
+					window = append(window, v)
+				}
+			} else {
+				v, ok := it.Next()
+				if !ok {
+					return nil, false
+				}
+				window = append(window[1:], v)
+			}
+			out := make([]T, size)
+			copy(out, window)
+			return out, true
+		},
+	}
+}
+
+// PartitionIter lazily splits it into values satisfying predicate and the
+// rest. Each branch pulls from the shared source on demand and queues values
+// belonging to the other branch, so memory grows when one branch runs ahead;
+// draining one branch completely buffers every value of the other.
+//
+// Example:
+//
+//	evens, odds := PartitionIter(it, func(n int) bool { return n%2 == 0 })
+func PartitionIter[T any](it Iterator[T], predicate func(T) bool) (Iterator[T], Iterator[T]) {
+	var matched, rest []T
+	branch := func(own *[]T, other *[]T, want bool) Iterator[T] {
+		return Iterator[T]{
+			next: func() (T, bool) {
+				if len(*own) > 0 {
+					v := (*own)[0]
+					var zero T
+					(*own)[0] = zero
+					*own = (*own)[1:]
+					return v, true
+				}
+				for {
+					v, ok := it.Next()
+					if !ok {
+						return v, false
+					}
+					if predicate(v) == want {
+						return v, true
+					}
+					*other = append(*other, v)
+				}
+			},
+		}
+	}
+	return branch(&matched, &rest, true), branch(&rest, &matched, false)
+}
+
+// FromSeq adapts a standard push-based iter.Seq into a pull-based Iterator
+// using iter.Pull. The sequence is resumed only when Next is called.
+//
+// Unlike the other constructors, FromSeq also returns a stop function, because
+// iter.Pull keeps the sequence suspended until it is released. Callers must
+// call stop, typically with defer, or the suspended sequence leaks when the
+// iterator is abandoned early (for example after Take). Draining the iterator
+// releases it too, and calling stop more than once is safe; Next reports
+// exhaustion after stop.
+//
+// Example:
+//
+//	it, stop := FromSeq(maps.Keys(index))
+//	defer stop()
+//	first := ToSlice(Take(it, 10))
+func FromSeq[T any](s iter.Seq[T]) (Iterator[T], func()) {
+	next, stop := iter.Pull(s)
+	done := false
+	return Iterator[T]{
+		next: func() (T, bool) {
+			if done {
+				var zero T
+				return zero, false
+			}
+			v, ok := next()
+			if !ok {
+				done = true
+				stop()
+			}
+			return v, ok
+		},
+	}, stop
+}
+
+// AsSeq exposes it as a standard iter.Seq for range-over-func loops. Pulling
+// stops as soon as yield returns false.
+//
+// Example:
+//
+//	for v := range AsSeq(Range(0, 3)) {
+//		fmt.Println(v)
+//	}
+func AsSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := it.Next()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -1,5 +1,12 @@
 package seq
 
+import (
+	"cmp"
+	"slices"
+
+	"github.com/charmingruby/fgp/result"
+)
+
 // Map transforms each element using fn and returns a new slice with the same
 // length as input.
 //
@@ -28,13 +35,13 @@ func Filter[T any](in []T, predicate func(T) bool) []T {
 	if len(in) == 0 {
 		return []T{}
 	}
-	result := make([]T, 0, len(in))
+	out := make([]T, 0, len(in))
 	for _, v := range in {
 		if predicate(v) {
-			result = append(result, v)
+			out = append(out, v)
 		}
 	}
-	return result
+	return out
 }
 
 // FlatMap applies fn to each element and concatenates the resulting slices.
@@ -63,6 +70,50 @@ func FlatMap[A any, B any](in []A, fn func(A) []B) []B {
 	return out
 }
 
+// Flatten concatenates the inner slices into a single new slice, pre-sized to
+// the total length.
+//
+// Example:
+//
+//	all := Flatten([][]int{{1, 2}, {}, {3}}) // [1,2,3]
+func Flatten[T any](in [][]T) []T {
+	total := 0
+	for _, inner := range in {
+		total += len(inner)
+	}
+	out := make([]T, 0, total)
+	for _, inner := range in {
+		out = append(out, inner...)
+	}
+	return out
+}
+
+// Concat is the variadic form of Flatten.
+//
+// Example:
+//
+//	merged := Concat(admins, editors, viewers)
+func Concat[T any](in ...[]T) []T {
+	return Flatten(in)
+}
+
+// Intersperse returns a new slice with sep placed between every pair of
+// adjacent elements.
+//
+// Example:
+//
+//	tokens := Intersperse([]int{1, 2, 3}, 0) // [1,0,2,0,3]
+func Intersperse[T any](in []T, sep T) []T {
+	out := make([]T, 0, max(0, 2*len(in)-1))
+	for i, v := range in {
+		if i > 0 {
+			out = append(out, sep)
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
 // FoldLeft reduces the slice from left to right using the provided accumulator.
 //
 // Example:
@@ -78,6 +129,22 @@ func FoldLeft[A any, B any](in []A, init B, fn func(B, A) B) B {
 	return acc
 }
 
+// FoldMap maps each element with fn and combines the results with combine,
+// starting from empty. Empty input returns empty.
+//
+// Example:
+//
+//	totalLen := FoldMap(words, 0, func(a, b int) int { return a + b },
+//		func(s string) int { return len(s) },
+//	)
+func FoldMap[A any, B any](in []A, empty B, combine func(B, B) B, fn func(A) B) B {
+	acc := empty
+	for _, v := range in {
+		acc = combine(acc, fn(v))
+	}
+	return acc
+}
+
 // Reduce applies fn across elements, returning false when slice empty.
 //
 // Example:
@@ -100,6 +167,101 @@ func Reduce[T any](in []T, fn func(T, T) T) (T, bool) {
 	return acc, true
 }
 
+// Min returns the smallest element, returning false when slice empty. On ties
+// the first occurrence wins.
+//
+// Example:
+//
+//	lowest, ok := Min([]int{3, 1, 2})
+func Min[T cmp.Ordered](in []T) (T, bool) {
+	return MinBy(in, func(v T) T { return v })
+}
+
+// Max returns the largest element, returning false when slice empty. On ties
+// the first occurrence wins.
+//
+// Example:
+//
+//	highest, ok := Max([]int{3, 1, 2})
+func Max[T cmp.Ordered](in []T) (T, bool) {
+	return MaxBy(in, func(v T) T { return v })
+}
+
+// MinBy returns the element with the smallest key, returning false when slice
+// empty. On ties the first occurrence wins.
+//
+// Example:
+//
+//	youngest, ok := MinBy(users, func(u User) int { return u.Age })
+func MinBy[T any, K cmp.Ordered](in []T, keySelector func(T) K) (T, bool) {
+	return extremeBy(in, keySelector, -1)
+}
+
+// MaxBy returns the element with the largest key, returning false when slice
+// empty. On ties the first occurrence wins.
+//
+// Example:
+//
+//	oldest, ok := MaxBy(users, func(u User) int { return u.Age })
+func MaxBy[T any, K cmp.Ordered](in []T, keySelector func(T) K) (T, bool) {
+	return extremeBy(in, keySelector, 1)
+}
+
+// Number is satisfied by the built-in integer and floating-point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum adds all elements, returning zero for an empty slice.
+//
+// Example:
+//
+//	total := Sum([]int{1, 2, 3}) // 6
+func Sum[T Number](in []T) T {
+	return SumBy(in, func(v T) T { return v })
+}
+
+// SumBy adds the values projected by fn.
+//
+// Example:
+//
+//	total := SumBy(orders, func(o Order) float64 { return o.Amount })
+func SumBy[T any, N Number](in []T, fn func(T) N) N {
+	var total N
+	for _, v := range in {
+		total += fn(v)
+	}
+	return total
+}
+
+// Average returns the arithmetic mean, returning false when slice empty.
+//
+// Example:
+//
+//	mean, ok := Average([]int{1, 2, 3, 4}) // 2.5
+func Average[T Number](in []T) (float64, bool) {
+	return AverageBy(in, func(v T) T { return v })
+}
+
+// AverageBy returns the mean of the values projected by fn, returning false
+// when slice empty.
+//
+// Example:
+//
+//	meanAge, ok := AverageBy(users, func(u User) int { return u.Age })
+func AverageBy[T any, N Number](in []T, fn func(T) N) (float64, bool) {
+	if len(in) == 0 {
+		return 0, false
+	}
+	total := 0.0
+	for _, v := range in {
+		total += float64(fn(v))
+	}
+	return total / float64(len(in)), true
+}
+
 // Find returns the first element satisfying predicate.
 //
 // Example:
@@ -157,6 +319,58 @@ func GroupBy[T any, K comparable](in []T, keySelector func(T) K) map[K][]T {
 	return groups
 }
 
+// ToMap builds a map from the key/value pair fn returns for each element.
+// When keys repeat, the last element wins.
+//
+// Example:
+//
+//	ages := ToMap(users, func(u User) (string, int) { return u.Name, u.Age })
+func ToMap[T any, K comparable, V any](in []T, fn func(T) (K, V)) map[K]V {
+	out := make(map[K]V, len(in))
+	for _, v := range in {
+		key, value := fn(v)
+		out[key] = value
+	}
+	return out
+}
+
+// KeyBy indexes elements by the key returned from keySelector. When keys
+// repeat, the last element wins.
+//
+// Example:
+//
+//	byID := KeyBy(users, func(u User) int { return u.ID })
+func KeyBy[T any, K comparable](in []T, keySelector func(T) K) map[K]T {
+	return ToMap(in, func(v T) (K, T) { return keySelector(v), v })
+}
+
+// IndexBy builds a map keyed by key, where the last value for a key wins, and
+// reports each key that appeared more than once in order of first collision so
+// callers can detect duplicate IDs.
+//
+// Example:
+//
+//	byID, dupes := IndexBy(users, func(u User) int { return u.ID })
+//	if len(dupes) > 0 {
+//		return fmt.Errorf("duplicate user ids: %v", dupes)
+//	}
+func IndexBy[T any, K comparable](in []T, key func(T) K) (map[K]T, []K) {
+	index := make(map[K]T, len(in))
+	collisions := []K{}
+	reported := make(map[K]struct{})
+	for _, v := range in {
+		k := key(v)
+		if _, exists := index[k]; exists {
+			if _, seen := reported[k]; !seen {
+				reported[k] = struct{}{}
+				collisions = append(collisions, k)
+			}
+		}
+		index[k] = v
+	}
+	return index, collisions
+}
+
 // DistinctBy removes duplicates determined by keySelector, preserving order.
 //
 // Example:
@@ -167,16 +381,16 @@ func DistinctBy[T any, K comparable](in []T, keySelector func(T) K) []T {
 		return []T{}
 	}
 	seen := make(map[K]struct{}, len(in))
-	result := make([]T, 0, len(in))
+	out := make([]T, 0, len(in))
 	for _, v := range in {
 		key := keySelector(v)
 		if _, ok := seen[key]; ok {
 			continue
 		}
 		seen[key] = struct{}{}
-		result = append(result, v)
+		out = append(out, v)
 	}
-	return result
+	return out
 }
 
 // Partition splits the slice into two slices based on predicate outcome.
@@ -202,6 +416,144 @@ func Partition[T any](in []T, predicate func(T) bool) ([]T, []T) {
 	return matches, rest
 }
 
+// Partition3 routes each element into one of three buckets based on classify,
+// which should return 0, 1, or 2. Out-of-range classifications fall into the
+// third bucket.
+//
+// Example:
+//
+//	low, medium, high := Partition3(alerts, func(a Alert) int { return a.Severity })
+func Partition3[T any](in []T, classify func(T) int) ([]T, []T, []T) {
+	first := make([]T, 0, len(in))
+	second := make([]T, 0, len(in))
+	third := make([]T, 0, len(in))
+	for _, v := range in {
+		switch classify(v) {
+		case 0:
+			first = append(first, v)
+		case 1:
+			second = append(second, v)
+		default:
+			third = append(third, v)
+		}
+	}
+	return first, second, third
+}
+
+// PartitionMap transforms and splits in a single pass: fn returns a left value,
+// a right value, and whether the left one should be kept (otherwise the right
+// one is).
+//
+// Example:
+//
+//	records, reports := PartitionMap(lines, func(l string) (Record, string, bool) {
+//		rec, err := parse(l)
+//		if err != nil {
+//			return Record{}, l + ": " + err.Error(), false
+//		}
+//		return rec, "", true
+//	})
+func PartitionMap[T any, L any, R any](in []T, fn func(T) (L, R, bool)) ([]L, []R) {
+	lefts := make([]L, 0, len(in))
+	rights := make([]R, 0, len(in))
+	for _, v := range in {
+		left, right, isLeft := fn(v)
+		if isLeft {
+			lefts = append(lefts, left)
+		} else {
+			rights = append(rights, right)
+		}
+	}
+	return lefts, rights
+}
+
+// Reverse returns a new slice with the elements in reverse order.
+//
+// Example:
+//
+//	reversed := Reverse([]int{1, 2, 3}) // [3,2,1]
+func Reverse[T any](in []T) []T {
+	out := make([]T, len(in))
+	for i, v := range in {
+		out[len(in)-1-i] = v
+	}
+	return out
+}
+
+// SortBy returns a new slice stably sorted ascending by the key returned from
+// keySelector.
+//
+// Example:
+//
+//	byAge := SortBy(users, func(u User) int { return u.Age })
+func SortBy[T any, K cmp.Ordered](in []T, keySelector func(T) K) []T {
+	return sortByKey(in, keySelector, 1)
+}
+
+// SortByDesc returns a new slice stably sorted descending by the key returned
+// from keySelector.
+//
+// Example:
+//
+//	newest := SortByDesc(posts, func(p Post) int64 { return p.CreatedAt })
+func SortByDesc[T any, K cmp.Ordered](in []T, keySelector func(T) K) []T {
+	return sortByKey(in, keySelector, -1)
+}
+
+// TakeLast returns a copy of the last n elements. n is clamped to the slice
+// length; zero or negative n yields an empty slice.
+//
+// Example:
+//
+//	tail := TakeLast([]int{1, 2, 3}, 2) // [2,3]
+func TakeLast[T any](in []T, n int) []T {
+	n = max(0, min(n, len(in)))
+	out := make([]T, n)
+	copy(out, in[len(in)-n:])
+	return out
+}
+
+// DropLast returns a copy without the last n elements. n is clamped to the
+// slice length; zero or negative n yields a copy of the whole slice.
+//
+// Example:
+//
+//	head := DropLast([]int{1, 2, 3}, 2) // [1]
+func DropLast[T any](in []T, n int) []T {
+	n = max(0, min(n, len(in)))
+	out := make([]T, len(in)-n)
+	copy(out, in)
+	return out
+}
+
+// SplitAt returns copies of the first n elements and the remainder. n is
+// clamped to the slice length.
+//
+// Example:
+//
+//	head, tail := SplitAt([]int{1, 2, 3}, 1) // [1], [2,3]
+func SplitAt[T any](in []T, n int) ([]T, []T) {
+	n = max(0, min(n, len(in)))
+	return DropLast(in, len(in)-n), TakeLast(in, len(in)-n)
+}
+
+// Span returns copies of the longest leading run satisfying predicate and the
+// remainder, evaluating predicate at most once per element.
+//
+// Example:
+//
+//	header, body := Span(lines, func(l string) bool { return l != "" })
+func Span[T any](in []T, predicate func(T) bool) ([]T, []T) {
+	n := len(in)
+	for i, v := range in {
+		if !predicate(v) {
+			n = i
+			break
+		}
+	}
+	return SplitAt(in, n)
+}
+
 // Zip combines two slices into a slice of pairs up to the shortest length.
 //
 // Example:
@@ -212,11 +564,122 @@ func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
 	if len(b) < limit {
 		limit = len(b)
 	}
-	result := make([]Pair[A, B], limit)
+	out := make([]Pair[A, B], limit)
+	for i := range limit {
+		out[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return out
+}
+
+// ZipWith combines two slices element-wise with fn up to the shortest length.
+//
+// Example:
+//
+//	sums := ZipWith([]int{1, 2}, []int{10, 20}, func(a, b int) int { return a + b })
+func ZipWith[A any, B any, C any](a []A, b []B, fn func(A, B) C) []C {
+	limit := min(len(a), len(b))
+	out := make([]C, limit)
+	for i := range limit {
+		out[i] = fn(a[i], b[i])
+	}
+	return out
+}
+
+// Zip3 combines three slices into tuples up to the shortest length.
+//
+// Example:
+//
+//	rows := Zip3(ids, names, scores)
+func Zip3[A any, B any, C any](a []A, b []B, c []C) []result.Tuple3[A, B, C] {
+	limit := min(len(a), len(b), len(c))
+	out := make([]result.Tuple3[A, B, C], limit)
 	for i := range limit {
-		result[i] = Pair[A, B]{First: a[i], Second: b[i]}
+		out[i] = result.Tuple3[A, B, C]{First: a[i], Second: b[i], Third: c[i]}
+	}
+	return out
+}
+
+// Unzip splits pairs into their first and second components, the inverse of
+// Zip.
+//
+// Example:
+//
+//	names, ages := Unzip(pairs)
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	firsts := make([]A, len(pairs))
+	seconds := make([]B, len(pairs))
+	for i, p := range pairs {
+		firsts[i], seconds[i] = p.First, p.Second
+	}
+	return firsts, seconds
+}
+
+// Product returns the Cartesian product of a and b in row-major order: every
+// element of a paired with each element of b.
+//
+// Example:
+//
+//	grid := Product([]string{"x", "y"}, []int{1, 2})
+//	// grid == [{x 1} {x 2} {y 1} {y 2}]
+func Product[A any, B any](a []A, b []B) []Pair[A, B] {
+	out := make([]Pair[A, B], 0, len(a)*len(b))
+	for _, first := range a {
+		for _, second := range b {
+			out = append(out, Pair[A, B]{First: first, Second: second})
+		}
+	}
+	return out
+}
+
+// Transpose turns rows into columns. The result has as many rows as the
+// longest input row; for ragged input, cells missing from shorter rows are
+// skipped, so later columns may be shorter. Use TransposePad to fill them.
+//
+// Example:
+//
+//	cols := Transpose([][]int{{1, 2, 3}, {4, 5}}) // [[1,4],[2,5],[3]]
+func Transpose[T any](matrix [][]T) [][]T {
+	width := 0
+	for _, row := range matrix {
+		width = max(width, len(row))
+	}
+	out := make([][]T, width)
+	for col := range width {
+		column := make([]T, 0, len(matrix))
+		for _, row := range matrix {
+			if col < len(row) {
+				column = append(column, row[col])
+			}
+		}
+		out[col] = column
+	}
+	return out
+}
+
+// TransposePad behaves like Transpose but fills cells missing from shorter rows
+// with fill, so every output row has len(matrix) elements.
+//
+// Example:
+//
+//	cols := TransposePad([][]int{{1, 2, 3}, {4, 5}}, 0) // [[1,4],[2,5],[3,0]]
+func TransposePad[T any](matrix [][]T, fill T) [][]T {
+	width := 0
+	for _, row := range matrix {
+		width = max(width, len(row))
 	}
-	return result
+	out := make([][]T, width)
+	for col := range width {
+		column := make([]T, len(matrix))
+		for i, row := range matrix {
+			if col < len(row) {
+				column[i] = row[col]
+			} else {
+				column[i] = fill
+			}
+		}
+		out[col] = column
+	}
+	return out
 }
 
 // Chunk splits the slice into consecutive sub-slices of size chunkSize. The
@@ -242,6 +705,67 @@ func Chunk[T any](in []T, chunkSize int) [][]T {
 	return chunks
 }
 
+// IndexedChunks behaves like Chunk but pairs each chunk with its zero-based
+// chunk index so parallel workers can report which chunk they processed.
+//
+// Example:
+//
+//	chunks := IndexedChunks([]int{1, 2, 3}, 2) // [{0 [1,2]} {1 [3]}]
+func IndexedChunks[T any](in []T, size int) []Pair[int, []T] {
+	chunks := Chunk(in, size)
+	indexed := make([]Pair[int, []T], len(chunks))
+	for i, chunk := range chunks {
+		indexed[i] = Pair[int, []T]{First: i, Second: chunk}
+	}
+	return indexed
+}
+
+// ChunkWhile splits the slice into runs of consecutive elements, starting a new
+// chunk whenever sameGroup(prev, cur) returns false. Each chunk is copied.
+//
+// Example:
+//
+//	runs := ChunkWhile([]int{1, 2, 4, 5}, func(prev, cur int) bool { return cur == prev+1 })
+//	// runs == [[1,2],[4,5]]
+func ChunkWhile[T any](in []T, sameGroup func(prev, cur T) bool) [][]T {
+	chunks := [][]T{}
+	start := 0
+	for i := 1; i <= len(in); i++ {
+		if i < len(in) && sameGroup(in[i-1], in[i]) {
+			continue
+		}
+		if i > start {
+			chunk := make([]T, i-start)
+			copy(chunk, in[start:i])
+			chunks = append(chunks, chunk)
+		}
+		start = i
+	}
+	return chunks
+}
+
+// GroupAdjacentBy groups consecutive elements sharing the key returned from
+// keySelector. Unlike GroupBy, a key that reappears later starts a new group.
+//
+// Example:
+//
+//	sessions := GroupAdjacentBy(events, func(e Event) string { return e.UserID })
+func GroupAdjacentBy[T any, K comparable](in []T, keySelector func(T) K) []Pair[K, []T] {
+	keys := Map(in, keySelector)
+	groups := make([]Pair[K, []T], 0)
+	start := 0
+	for i := 1; i <= len(in); i++ {
+		if i < len(in) && keys[i] == keys[i-1] {
+			continue
+		}
+		group := make([]T, i-start)
+		copy(group, in[start:i])
+		groups = append(groups, Pair[K, []T]{First: keys[start], Second: group})
+		start = i
+	}
+	return groups
+}
+
 // Window returns a sliding window of size windowSize across the slice. Each
 // window is copied to avoid sharing memory with input.
 //
@@ -261,6 +785,39 @@ func Window[T any](in []T, windowSize int) [][]T {
 	return windows
 }
 
+// SlideAggregate applies agg to each sliding window of size windowSize, which
+// makes moving averages or maxima a single call. Empty input and oversized
+// windows return an empty slice.
+//
+// Example:
+//
+//	movingSum := SlideAggregate([]int{1, 2, 3, 4}, 2, func(w []int) int { return w[0] + w[1] })
+//	// movingSum == []int{3, 5, 7}
+func SlideAggregate[T any, R any](in []T, windowSize int, agg func([]T) R) []R {
+	return Map(Window(in, windowSize), agg)
+}
+
+// WindowStep returns windows of size windowSize advanced by step elements each
+// time. Trailing partial windows are dropped, and each window is copied to
+// avoid sharing memory with input.
+//
+// Example:
+//
+//	windows := WindowStep([]int{1, 2, 3, 4, 5}, 2, 2)
+//	// windows == [][]int{{1, 2}, {3, 4}}
+func WindowStep[T any](in []T, windowSize, step int) [][]T {
+	if windowSize <= 0 || step <= 0 || windowSize > len(in) {
+		return [][]T{}
+	}
+	windows := make([][]T, 0, (len(in)-windowSize)/step+1)
+	for i := 0; i+windowSize <= len(in); i += step {
+		window := make([]T, windowSize)
+		copy(window, in[i:i+windowSize])
+		windows = append(windows, window)
+	}
+	return windows
+}
+
 // ScanLeft returns the running accumulation values, including the initial seed
 // as the first element of the returned slice.
 //
@@ -269,14 +826,33 @@ func Window[T any](in []T, windowSize int) [][]T {
 //	sums := ScanLeft([]int{1,2,3}, 0, func(acc, v int) int { return acc + v })
 //	// sums == []int{0, 1, 3, 6}
 func ScanLeft[A any, B any](in []A, init B, fn func(B, A) B) []B {
-	result := make([]B, len(in)+1)
-	result[0] = init
+	out := make([]B, len(in)+1)
+	out[0] = init
 	acc := init
 	for i, v := range in {
 		acc = fn(acc, v)
-		result[i+1] = acc
+		out[i+1] = acc
 	}
-	return result
+	return out
+}
+
+// Scan1 returns the running accumulation values using the first element as the
+// seed. It returns an empty slice for empty input.
+//
+// Example:
+//
+//	totals := Scan1([]int{3, 1, 4}, func(acc, v int) int { return acc + v })
+//	// totals == []int{3, 4, 8}
+func Scan1[T any](in []T, fn func(T, T) T) []T {
+	if len(in) == 0 {
+		return []T{}
+	}
+	out := make([]T, len(in))
+	out[0] = in[0]
+	for i := 1; i < len(in); i++ {
+		out[i] = fn(out[i-1], in[i])
+	}
+	return out
 }
 
 // Collect fuses filter + map by executing fn for each element and appending the
@@ -314,3 +890,31 @@ type Pair[A any, B any] struct {
 	First  A
 	Second B
 }
+
+// extremeBy keeps the first element whose key compares as want against every
+// later key, so ties resolve to the earliest element.
+func extremeBy[T any, K cmp.Ordered](in []T, keySelector func(T) K, want int) (T, bool) {
+	if len(in) == 0 {
+		var zero T
+		return zero, false
+	}
+	best := in[0]
+	bestKey := keySelector(best)
+	for _, v := range in[1:] {
+		if key := keySelector(v); cmp.Compare(key, bestKey) == want {
+			best, bestKey = v, key
+		}
+	}
+	return best, true
+}
+
+// sortByKey stably sorts a copy of in, multiplying key comparisons by
+// direction so -1 flips the order.
+func sortByKey[T any, K cmp.Ordered](in []T, keySelector func(T) K, direction int) []T {
+	out := make([]T, len(in))
+	copy(out, in)
+	slices.SortStableFunc(out, func(a, b T) int {
+		return direction * cmp.Compare(keySelector(a), keySelector(b))
+	})
+	return out
+}
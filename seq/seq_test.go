@@ -1,9 +1,12 @@
 package seq_test
 
 import (
+	"math"
 	"reflect"
+	"strconv"
 	"testing"
 
+	"github.com/charmingruby/fgp/result"
 	"github.com/charmingruby/fgp/seq"
 )
 
@@ -104,3 +107,662 @@ func TestChunkWindowScanCollect(t *testing.T) {
 		t.Fatalf("collect mismatch %v", collected)
 	}
 }
+
+func TestScan1(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	if got := seq.Scan1([]int{}, sum); len(got) != 0 {
+		t.Fatalf("expected empty scan, got %v", got)
+	}
+	if got := seq.Scan1([]int{5}, sum); !reflect.DeepEqual(got, []int{5}) {
+		t.Fatalf("single element mismatch %v", got)
+	}
+	if got := seq.Scan1([]int{1, 2, 3, 4}, sum); !reflect.DeepEqual(got, []int{1, 3, 6, 10}) {
+		t.Fatalf("scan1 mismatch %v", got)
+	}
+}
+
+func TestWindowStep(t *testing.T) {
+	given := []int{1, 2, 3, 4, 5}
+	if got := seq.WindowStep(given, 2, 1); !reflect.DeepEqual(got, seq.Window(given, 2)) {
+		t.Fatalf("step 1 should match window, got %v", got)
+	}
+	if got := seq.WindowStep(given, 2, 2); !reflect.DeepEqual(got, [][]int{{1, 2}, {3, 4}}) {
+		t.Fatalf("expected partial tail dropped, got %v", got)
+	}
+	if got := seq.WindowStep(given, 3, 2); !reflect.DeepEqual(got, [][]int{{1, 2, 3}, {3, 4, 5}}) {
+		t.Fatalf("unexpected overlapping windows %v", got)
+	}
+	if got := seq.WindowStep(given, 2, 0); len(got) != 0 {
+		t.Fatalf("expected empty output for step 0, got %v", got)
+	}
+}
+
+func TestIndexedChunks(t *testing.T) {
+	even := seq.IndexedChunks([]int{1, 2, 3, 4}, 2)
+	want := []seq.Pair[int, []int]{{First: 0, Second: []int{1, 2}}, {First: 1, Second: []int{3, 4}}}
+	if !reflect.DeepEqual(even, want) {
+		t.Fatalf("unexpected even chunks %v", even)
+	}
+	uneven := seq.IndexedChunks([]int{1, 2, 3}, 2)
+	if len(uneven) != 2 || uneven[1].First != 1 || !reflect.DeepEqual(uneven[1].Second, []int{3}) {
+		t.Fatalf("unexpected uneven chunks %v", uneven)
+	}
+}
+
+func TestPartition3(t *testing.T) {
+	neg, zero, pos := seq.Partition3([]int{-2, 0, 3, -1, 5}, func(v int) int {
+		switch {
+		case v < 0:
+			return 0
+		case v == 0:
+			return 1
+		default:
+			return 2
+		}
+	})
+	if !reflect.DeepEqual(neg, []int{-2, -1}) || !reflect.DeepEqual(zero, []int{0}) ||
+		!reflect.DeepEqual(pos, []int{3, 5}) {
+		t.Fatalf("unexpected buckets %v %v %v", neg, zero, pos)
+	}
+	_, _, rest := seq.Partition3([]int{1, 2}, func(v int) int { return v * 10 })
+	if !reflect.DeepEqual(rest, []int{1, 2}) {
+		t.Fatalf("expected out-of-range values in third bucket, got %v", rest)
+	}
+}
+
+func TestFoldMap(t *testing.T) {
+	words := []string{"go", "is", "fun"}
+	add := func(a, b int) int { return a + b }
+	if got := seq.FoldMap(words, 0, add, func(s string) int { return len(s) }); got != 7 {
+		t.Fatalf("unexpected total length %d", got)
+	}
+	concat := func(a, b string) string { return a + b }
+	exclaim := func(s string) string { return s + "!" }
+	if got := seq.FoldMap(words, "", concat, exclaim); got != "go!is!fun!" {
+		t.Fatalf("unexpected concatenation %q", got)
+	}
+	if got := seq.FoldMap([]string{}, -1, add, func(s string) int { return len(s) }); got != -1 {
+		t.Fatalf("expected empty value for empty input, got %d", got)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	type row struct {
+		ID   int
+		Name string
+	}
+	rows := []row{{1, "a"}, {2, "b"}, {1, "c"}, {3, "d"}, {1, "e"}, {2, "f"}}
+	index, collisions := seq.IndexBy(rows, func(r row) int { return r.ID })
+	if !reflect.DeepEqual(collisions, []int{1, 2}) {
+		t.Fatalf("unexpected collisions %v", collisions)
+	}
+	if len(index) != 3 || index[1].Name != "e" || index[2].Name != "f" || index[3].Name != "d" {
+		t.Fatalf("unexpected index %v", index)
+	}
+	_, none := seq.IndexBy([]int{1, 2, 3}, func(v int) int { return v })
+	if len(none) != 0 {
+		t.Fatalf("expected no collisions, got %v", none)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	grid := seq.Product([]string{"x", "y"}, []int{1, 2, 3})
+	if len(grid) != 6 {
+		t.Fatalf("expected 6 combinations, got %d", len(grid))
+	}
+	want := []seq.Pair[string, int]{
+		{First: "x", Second: 1}, {First: "x", Second: 2}, {First: "x", Second: 3},
+		{First: "y", Second: 1}, {First: "y", Second: 2}, {First: "y", Second: 3},
+	}
+	if !reflect.DeepEqual(grid, want) {
+		t.Fatalf("unexpected ordering %v", grid)
+	}
+	if empty := seq.Product([]int{}, []int{1}); len(empty) != 0 {
+		t.Fatalf("expected empty product, got %v", empty)
+	}
+}
+
+func TestSlideAggregate(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+	sum := func(window []int) int {
+		return seq.FoldLeft(window, 0, func(acc, v int) int { return acc + v })
+	}
+	moving := seq.SlideAggregate(in, 3, sum)
+	if len(moving) != len(in)-3+1 || !reflect.DeepEqual(moving, []int{6, 9, 12}) {
+		t.Fatalf("unexpected moving sum %v", moving)
+	}
+	if got := seq.SlideAggregate(in, 6, sum); len(got) != 0 {
+		t.Fatalf("expected empty output for oversized window, got %v", got)
+	}
+}
+
+func TestZipIter(t *testing.T) {
+	zipped := seq.ToSlice(seq.ZipIter(seq.Range(0, 3), seq.FromSlice([]string{"x", "y"})))
+	want := []seq.Pair[int, string]{{First: 0, Second: "x"}, {First: 1, Second: "y"}}
+	if !reflect.DeepEqual(zipped, want) {
+		t.Fatalf("unexpected pairs %v", zipped)
+	}
+	pulled := 0
+	counted := seq.MapIter(seq.FromSlice([]int{1, 2, 3}), func(v int) int {
+		pulled++
+		return v
+	})
+	seq.ToSlice(seq.ZipIter(seq.Range(0, 2), counted))
+	if pulled != 2 {
+		t.Fatalf("expected b to be pulled twice, got %d", pulled)
+	}
+}
+
+func TestFlatMapIter(t *testing.T) {
+	expanded := seq.FlatMapIter(seq.FromSlice([]int{2, 0, 3}), func(n int) seq.Iterator[int] {
+		return seq.Range(0, n)
+	})
+	if got := seq.ToSlice(expanded); !reflect.DeepEqual(got, []int{0, 1, 0, 1, 2}) {
+		t.Fatalf("unexpected flattened values %v", got)
+	}
+	empty := seq.FlatMapIter(seq.FromSlice([]int{}), func(n int) seq.Iterator[int] { return seq.Range(0, n) })
+	if got := seq.ToSlice(empty); len(got) != 0 {
+		t.Fatalf("expected empty output, got %v", got)
+	}
+}
+
+func TestChain(t *testing.T) {
+	chained := seq.Chain(seq.FromSlice([]int{1, 2}), seq.FromSlice([]int{}), seq.Range(3, 5))
+	if got := seq.ToSlice(chained); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("unexpected chained values %v", got)
+	}
+	if got := seq.ToSlice(seq.Chain[int]()); len(got) != 0 {
+		t.Fatalf("expected empty chain, got %v", got)
+	}
+	both := seq.ConcatIter(seq.FromSlice([]string{"a"}), seq.FromSlice([]string{"b"}))
+	if got := seq.ToSlice(both); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Fatalf("unexpected concat values %v", got)
+	}
+}
+
+func TestFoldLeftReduceIter(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	if got := seq.FoldLeftIter(seq.Range(1, 5), 0, sum); got != 10 {
+		t.Fatalf("unexpected fold %d", got)
+	}
+	if got := seq.FoldLeftIter(seq.Range(0, 0), 7, sum); got != 7 {
+		t.Fatalf("expected init for empty iterator, got %d", got)
+	}
+	if got, ok := seq.ReduceIter(seq.Range(1, 5), sum); !ok || got != 10 {
+		t.Fatalf("unexpected reduce %d %v", got, ok)
+	}
+	if _, ok := seq.ReduceIter(seq.Range(0, 0), sum); ok {
+		t.Fatalf("expected empty reduce to report false")
+	}
+}
+
+func TestPeekable(t *testing.T) {
+	p := seq.Peekable(seq.FromSlice([]int{1, 2, 3}))
+	if v, ok := p.Peek(); !ok || v != 1 {
+		t.Fatalf("unexpected first peek %d %v", v, ok)
+	}
+	if v, ok := p.Peek(); !ok || v != 1 {
+		t.Fatalf("repeated peek should not advance, got %d", v)
+	}
+	if v, _ := p.Next(); v != 1 {
+		t.Fatalf("expected next to return peeked value, got %d", v)
+	}
+	if v, _ := p.Next(); v != 2 {
+		t.Fatalf("expected 2 without peek, got %d", v)
+	}
+	if v, _ := p.Peek(); v != 3 {
+		t.Fatalf("expected to peek 3, got %d", v)
+	}
+	if v, _ := p.Next(); v != 3 {
+		t.Fatalf("expected 3, got %d", v)
+	}
+	if _, ok := p.Peek(); ok {
+		t.Fatalf("expected exhausted peek")
+	}
+	if _, ok := p.Next(); ok {
+		t.Fatalf("expected exhausted next")
+	}
+}
+
+func TestTee(t *testing.T) {
+	branches := seq.Tee(seq.Range(0, 4), 2)
+	if len(branches) != 2 {
+		t.Fatalf("expected two branches, got %d", len(branches))
+	}
+	first := seq.ToSlice(branches[0])
+	second := seq.ToSlice(branches[1])
+	if !reflect.DeepEqual(first, []int{0, 1, 2, 3}) || !reflect.DeepEqual(first, second) {
+		t.Fatalf("branches diverged %v %v", first, second)
+	}
+	interleaved := seq.Tee(seq.FromSlice([]string{"a", "b"}), 3)
+	a, _ := interleaved[0].Next()
+	b, _ := interleaved[1].Next()
+	c, _ := interleaved[2].Next()
+	if a != "a" || b != "a" || c != "a" {
+		t.Fatalf("unexpected interleaved heads %q %q %q", a, b, c)
+	}
+	if got := seq.ToSlice(interleaved[2]); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Fatalf("unexpected tail %v", got)
+	}
+	if got := seq.Tee(seq.Range(0, 1), 0); len(got) != 0 {
+		t.Fatalf("expected no branches for n=0, got %d", len(got))
+	}
+}
+
+func TestScanLeftIter(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	got := seq.ToSlice(seq.ScanLeftIter(seq.FromSlice([]int{1, 2, 3}), 0, sum))
+	if !reflect.DeepEqual(got, seq.ScanLeft([]int{1, 2, 3}, 0, sum)) {
+		t.Fatalf("expected parity with ScanLeft, got %v", got)
+	}
+	running := seq.ToSlice(seq.Take(seq.ScanLeftIter(seq.Repeat(2), 0, sum), 4))
+	if !reflect.DeepEqual(running, []int{0, 2, 4, 6}) {
+		t.Fatalf("unexpected running totals %v", running)
+	}
+}
+
+func TestToMapKeyBy(t *testing.T) {
+	lengths := seq.ToMap([]string{"go", "rust", "go"}, func(s string) (string, int) { return s, len(s) })
+	if !reflect.DeepEqual(lengths, map[string]int{"go": 2, "rust": 4}) {
+		t.Fatalf("unexpected map %v", lengths)
+	}
+	type user struct {
+		Name string
+		ID   int
+	}
+	byID := seq.KeyBy([]user{{"a", 1}, {"b", 2}, {"c", 1}}, func(u user) int { return u.ID })
+	if len(byID) != 2 || byID[1].Name != "c" || byID[2].Name != "b" {
+		t.Fatalf("expected last value to win, got %v", byID)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if v, ok := seq.Min([]int{3, 1, 2, 1}); !ok || v != 1 {
+		t.Fatalf("unexpected min %d", v)
+	}
+	if v, ok := seq.Max([]string{"b", "c", "a"}); !ok || v != "c" {
+		t.Fatalf("unexpected max %q", v)
+	}
+	if _, ok := seq.Min([]float64{}); ok {
+		t.Fatalf("expected empty min to report false")
+	}
+	type user struct {
+		Name string
+		Age  int
+	}
+	users := []user{{"a", 30}, {"b", 20}, {"c", 40}, {"d", 20}, {"e", 40}}
+	age := func(u user) int { return u.Age }
+	if u, ok := seq.MinBy(users, age); !ok || u.Name != "b" {
+		t.Fatalf("expected first youngest, got %v", u)
+	}
+	if u, ok := seq.MaxBy(users, age); !ok || u.Name != "c" {
+		t.Fatalf("expected first oldest, got %v", u)
+	}
+	if _, ok := seq.MaxBy([]user{}, age); ok {
+		t.Fatalf("expected empty maxby to report false")
+	}
+}
+
+func TestSumAverage(t *testing.T) {
+	if got := seq.Sum([]int{1, 2, 3}); got != 6 {
+		t.Fatalf("unexpected sum %d", got)
+	}
+	if got := seq.Sum([]float64{}); got != 0 {
+		t.Fatalf("expected zero sum for empty input, got %v", got)
+	}
+	words := []string{"go", "fp", "seq"}
+	if got := seq.SumBy(words, func(s string) int { return len(s) }); got != 7 {
+		t.Fatalf("unexpected projected sum %d", got)
+	}
+	if got, ok := seq.Average([]int{1, 2, 3, 4}); !ok || got != 2.5 {
+		t.Fatalf("unexpected average %v", got)
+	}
+	if _, ok := seq.Average([]int{}); ok {
+		t.Fatalf("expected empty average to report false")
+	}
+	if got, ok := seq.AverageBy(words, func(s string) uint8 { return uint8(len(s)) }); !ok || got != 7.0/3 {
+		t.Fatalf("unexpected projected average %v", got)
+	}
+}
+
+func TestReverseSortBy(t *testing.T) {
+	in := []int{1, 2, 3}
+	if got := seq.Reverse(in); !reflect.DeepEqual(got, []int{3, 2, 1}) || in[0] != 1 {
+		t.Fatalf("unexpected reverse %v (input %v)", got, in)
+	}
+	if got := seq.Reverse([]int{}); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", got)
+	}
+	type user struct {
+		Name string
+		Age  int
+	}
+	users := []user{{"a", 30}, {"b", 20}, {"c", 30}, {"d", 10}}
+	age := func(u user) int { return u.Age }
+	asc := seq.SortBy(users, age)
+	if !reflect.DeepEqual(asc, []user{{"d", 10}, {"b", 20}, {"a", 30}, {"c", 30}}) {
+		t.Fatalf("unexpected ascending order %v", asc)
+	}
+	desc := seq.SortByDesc(users, age)
+	if !reflect.DeepEqual(desc, []user{{"a", 30}, {"c", 30}, {"b", 20}, {"d", 10}}) {
+		t.Fatalf("unexpected descending order %v", desc)
+	}
+	if users[0].Name != "a" || users[3].Name != "d" {
+		t.Fatalf("input mutated %v", users)
+	}
+}
+
+func TestTakeLastDropLast(t *testing.T) {
+	in := []int{1, 2, 3}
+	if got := seq.TakeLast(in, 2); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Fatalf("unexpected take last %v", got)
+	}
+	if got := seq.DropLast(in, 2); !reflect.DeepEqual(got, []int{1}) {
+		t.Fatalf("unexpected drop last %v", got)
+	}
+	if got := seq.TakeLast(in, 10); !reflect.DeepEqual(got, in) {
+		t.Fatalf("expected clamped take last, got %v", got)
+	}
+	if got := seq.DropLast(in, 10); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty drop last, got %#v", got)
+	}
+	if got := seq.TakeLast(in, -1); len(got) != 0 {
+		t.Fatalf("expected empty take last for negative n, got %v", got)
+	}
+	full := seq.DropLast(in, 0)
+	full[0] = 99
+	if !reflect.DeepEqual(full, []int{99, 2, 3}) || in[0] != 1 {
+		t.Fatalf("expected independent full copy, got %v (input %v)", full, in)
+	}
+}
+
+func TestChunkWindowIter(t *testing.T) {
+	chunks := seq.ToSlice(seq.ChunkIter(seq.Range(0, 5), 2))
+	if !reflect.DeepEqual(chunks, [][]int{{0, 1}, {2, 3}, {4}}) {
+		t.Fatalf("unexpected chunks %v", chunks)
+	}
+	if got := seq.ToSlice(seq.ChunkIter(seq.Range(0, 5), 0)); len(got) != 0 {
+		t.Fatalf("expected no chunks for size 0, got %v", got)
+	}
+	naturals := seq.Iterate(0, func(n int) int { return n + 1 })
+	batches := seq.ToSlice(seq.Take(seq.ChunkIter(naturals, 3), 2))
+	if !reflect.DeepEqual(batches, [][]int{{0, 1, 2}, {3, 4, 5}}) {
+		t.Fatalf("unexpected unbounded batches %v", batches)
+	}
+	given := []int{1, 2, 3, 4}
+	windows := seq.ToSlice(seq.WindowIter(seq.FromSlice(given), 2))
+	if !reflect.DeepEqual(windows, seq.Window(given, 2)) {
+		t.Fatalf("expected parity with Window, got %v", windows)
+	}
+	if got := seq.ToSlice(seq.WindowIter(seq.FromSlice(given), 5)); len(got) != 0 {
+		t.Fatalf("expected no windows for oversized size, got %v", got)
+	}
+}
+
+func TestPartitionIter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	evens, odds := seq.PartitionIter(seq.Range(0, 7), even)
+	if got := seq.ToSlice(evens); !reflect.DeepEqual(got, []int{0, 2, 4, 6}) {
+		t.Fatalf("unexpected matches %v", got)
+	}
+	if got := seq.ToSlice(odds); !reflect.DeepEqual(got, []int{1, 3, 5}) {
+		t.Fatalf("unexpected rest %v", got)
+	}
+	matches, rest := seq.PartitionIter(seq.FromSlice([]int{1, 2, 3, 4}), even)
+	first, _ := rest.Next()
+	second, _ := matches.Next()
+	third, _ := rest.Next()
+	if first != 1 || second != 2 || third != 3 {
+		t.Fatalf("unexpected interleaved values %d %d %d", first, second, third)
+	}
+	if got := seq.ToSlice(matches); !reflect.DeepEqual(got, []int{4}) {
+		t.Fatalf("unexpected remaining matches %v", got)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	names, ages := seq.Unzip(seq.Zip([]string{"a", "b", "c"}, []int{1, 2}))
+	if !reflect.DeepEqual(names, []string{"a", "b"}) || !reflect.DeepEqual(ages, []int{1, 2}) {
+		t.Fatalf("unexpected columns %v %v", names, ages)
+	}
+	firsts, seconds := seq.Unzip([]seq.Pair[int, int]{})
+	if firsts == nil || seconds == nil || len(firsts) != 0 || len(seconds) != 0 {
+		t.Fatalf("expected empty non-nil slices, got %#v %#v", firsts, seconds)
+	}
+}
+
+func TestFlattenConcat(t *testing.T) {
+	nested := [][]int{{1, 2}, {}, {3}}
+	flat := seq.Flatten(nested)
+	if !reflect.DeepEqual(flat, []int{1, 2, 3}) {
+		t.Fatalf("unexpected flatten %v", flat)
+	}
+	flat[0] = 99
+	if nested[0][0] != 1 {
+		t.Fatalf("flatten shares memory with input")
+	}
+	if got := seq.Concat([]string{"a"}, nil, []string{"b", "c"}); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("unexpected concat %v", got)
+	}
+	if got := seq.Concat[int](); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", got)
+	}
+}
+
+func TestZipWithZip3(t *testing.T) {
+	sums := seq.ZipWith([]int{1, 2, 3}, []int{10, 20}, func(a, b int) int { return a + b })
+	if !reflect.DeepEqual(sums, []int{11, 22}) {
+		t.Fatalf("unexpected zipwith %v", sums)
+	}
+	rows := seq.Zip3([]int{1, 2}, []string{"a", "b", "c"}, []bool{true, false})
+	want := []result.Tuple3[int, string, bool]{
+		{First: 1, Second: "a", Third: true},
+		{First: 2, Second: "b", Third: false},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("unexpected zip3 %v", rows)
+	}
+	if got := seq.Zip3([]int{}, []int{1}, []int{1}); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil zip3, got %#v", got)
+	}
+}
+
+func TestPartitionMap(t *testing.T) {
+	values, reports := seq.PartitionMap([]string{"4", "x", "9", "y"}, func(s string) (int, string, bool) {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, "bad: " + s, false
+		}
+		return v * 2, "", true
+	})
+	if !reflect.DeepEqual(values, []int{8, 18}) {
+		t.Fatalf("unexpected lefts %v", values)
+	}
+	if !reflect.DeepEqual(reports, []string{"bad: x", "bad: y"}) {
+		t.Fatalf("unexpected rights %v", reports)
+	}
+}
+
+func TestChunkWhileGroupAdjacentBy(t *testing.T) {
+	in := []int{1, 2, 4, 5, 6, 9}
+	runs := seq.ChunkWhile(in, func(prev, cur int) bool { return cur == prev+1 })
+	if !reflect.DeepEqual(runs, [][]int{{1, 2}, {4, 5, 6}, {9}}) {
+		t.Fatalf("unexpected runs %v", runs)
+	}
+	runs[0][0] = 99
+	if in[0] != 1 {
+		t.Fatalf("chunks alias the input")
+	}
+	if got := seq.ChunkWhile([]int{}, func(int, int) bool { return true }); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil output, got %#v", got)
+	}
+	groups := seq.GroupAdjacentBy([]string{"a1", "a2", "b1", "a3"}, func(s string) byte { return s[0] })
+	want := []seq.Pair[byte, []string]{
+		{First: 'a', Second: []string{"a1", "a2"}},
+		{First: 'b', Second: []string{"b1"}},
+		{First: 'a', Second: []string{"a3"}},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Fatalf("unexpected adjacent groups %v", groups)
+	}
+}
+
+func TestRangeStep(t *testing.T) {
+	if got := seq.ToSlice(seq.RangeStep(0, 10, 3)); !reflect.DeepEqual(got, []int{0, 3, 6, 9}) {
+		t.Fatalf("unexpected ascending range %v", got)
+	}
+	if got := seq.ToSlice(seq.RangeStep(5, 0, -2)); !reflect.DeepEqual(got, []int{5, 3, 1}) {
+		t.Fatalf("unexpected descending range %v", got)
+	}
+	upper := seq.ToSlice(seq.Take(seq.RangeStep(math.MaxInt-3, math.MaxInt, 2), 5))
+	if !reflect.DeepEqual(upper, []int{math.MaxInt - 3, math.MaxInt - 1}) {
+		t.Fatalf("expected range to stop before overflowing, got %v", upper)
+	}
+	lower := seq.ToSlice(seq.Take(seq.RangeStep(math.MinInt+3, math.MinInt, -2), 5))
+	if !reflect.DeepEqual(lower, []int{math.MinInt + 3, math.MinInt + 1}) {
+		t.Fatalf("expected descending range to stop before overflowing, got %v", lower)
+	}
+	wide := seq.ToSlice(seq.RangeStep(math.MinInt, math.MaxInt, math.MaxInt))
+	if !reflect.DeepEqual(wide, []int{math.MinInt, -1, math.MaxInt - 1}) {
+		t.Fatalf("unexpected full-width range %v", wide)
+	}
+	for _, args := range [][3]int{{0, 5, 0}, {0, 5, -1}, {5, 0, 1}, {3, 3, 1}} {
+		if got := seq.ToSlice(seq.RangeStep(args[0], args[1], args[2])); len(got) != 0 {
+			t.Fatalf("expected empty range for %v, got %v", args, got)
+		}
+	}
+}
+
+func TestCycle(t *testing.T) {
+	got := seq.ToSlice(seq.Take(seq.Cycle([]string{"a", "b"}), 5))
+	if !reflect.DeepEqual(got, []string{"a", "b", "a", "b", "a"}) {
+		t.Fatalf("unexpected cycle %v", got)
+	}
+	if _, ok := seq.Cycle([]int{}).Next(); ok {
+		t.Fatalf("expected empty cycle for empty input")
+	}
+	assigned := seq.ToSlice(seq.ZipIter(seq.Range(0, 3), seq.Cycle([]string{"w1", "w2"})))
+	if assigned[2].Second != "w1" {
+		t.Fatalf("expected round-robin assignment, got %v", assigned)
+	}
+}
+
+func TestSplitAtSpan(t *testing.T) {
+	in := []int{1, 2, 3}
+	head, tail := seq.SplitAt(in, 1)
+	if !reflect.DeepEqual(head, []int{1}) || !reflect.DeepEqual(tail, []int{2, 3}) {
+		t.Fatalf("unexpected split %v %v", head, tail)
+	}
+	head, tail = seq.SplitAt(in, 10)
+	if !reflect.DeepEqual(head, in) || len(tail) != 0 {
+		t.Fatalf("expected clamped split, got %v %v", head, tail)
+	}
+	head[0] = 99
+	if in[0] != 1 {
+		t.Fatalf("split shares memory with input")
+	}
+	calls := 0
+	small, rest := seq.Span([]int{1, 2, 5, 1}, func(v int) bool {
+		calls++
+		return v < 3
+	})
+	if !reflect.DeepEqual(small, []int{1, 2}) || !reflect.DeepEqual(rest, []int{5, 1}) || calls != 3 {
+		t.Fatalf("unexpected span %v %v after %d calls", small, rest, calls)
+	}
+	empty, none := seq.Span([]int{}, func(int) bool { return true })
+	if empty == nil || none == nil || len(empty) != 0 || len(none) != 0 {
+		t.Fatalf("expected empty non-nil slices, got %#v %#v", empty, none)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	rect := seq.Transpose([][]int{{1, 2, 3}, {4, 5, 6}})
+	if !reflect.DeepEqual(rect, [][]int{{1, 4}, {2, 5}, {3, 6}}) {
+		t.Fatalf("unexpected rectangular transpose %v", rect)
+	}
+	ragged := [][]int{{1, 2, 3}, {4}, {5, 6}}
+	if got := seq.Transpose(ragged); !reflect.DeepEqual(got, [][]int{{1, 4, 5}, {2, 6}, {3}}) {
+		t.Fatalf("unexpected ragged transpose %v", got)
+	}
+	if got := seq.TransposePad(ragged, 0); !reflect.DeepEqual(got, [][]int{{1, 4, 5}, {2, 0, 6}, {3, 0, 0}}) {
+		t.Fatalf("unexpected padded transpose %v", got)
+	}
+	if got := seq.Transpose([][]int{}); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil output, got %#v", got)
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	if got := seq.Intersperse([]int{1, 2, 3}, 0); !reflect.DeepEqual(got, []int{1, 0, 2, 0, 3}) {
+		t.Fatalf("unexpected intersperse %v", got)
+	}
+	single := []string{"a"}
+	got := seq.Intersperse(single, ",")
+	got[0] = "b"
+	if single[0] != "a" {
+		t.Fatalf("expected a copy for single element input")
+	}
+	if got := seq.Intersperse([]int{}, 0); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil output, got %#v", got)
+	}
+}
+
+func TestSeqBridges(t *testing.T) {
+	pulledIter, stopPull := seq.FromSeq(seq.AsSeq(seq.Range(0, 4)))
+	defer stopPull()
+	roundTrip := seq.ToSlice(pulledIter)
+	if !reflect.DeepEqual(roundTrip, []int{0, 1, 2, 3}) {
+		t.Fatalf("unexpected round trip %v", roundTrip)
+	}
+	pulled := 0
+	counted := seq.MapIter(seq.Range(0, 10), func(v int) int {
+		pulled++
+		return v
+	})
+	var seen []int
+	for v := range seq.AsSeq(counted) {
+		if v == 2 {
+			break
+		}
+		seen = append(seen, v)
+	}
+	if !reflect.DeepEqual(seen, []int{0, 1}) || pulled != 3 {
+		t.Fatalf("expected early stop after 3 pulls, got %v after %d", seen, pulled)
+	}
+	it, stop := seq.FromSeq(seq.AsSeq(seq.Range(0, 1)))
+	defer stop()
+	it.Next()
+	if _, ok := it.Next(); ok {
+		t.Fatalf("expected exhausted iterator")
+	}
+	if _, ok := it.Next(); ok {
+		t.Fatalf("expected iterator to stay exhausted")
+	}
+}
+
+func TestFromSeqStop(t *testing.T) {
+	released := false
+	source := func(yield func(int) bool) {
+		defer func() { released = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	it, stop := seq.FromSeq(source)
+	if got := seq.ToSlice(seq.Take(it, 3)); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Fatalf("unexpected prefix %v", got)
+	}
+	if released {
+		t.Fatalf("expected sequence to stay suspended before stop")
+	}
+	stop()
+	if !released {
+		t.Fatalf("expected stop to release the abandoned sequence")
+	}
+	if _, ok := it.Next(); ok {
+		t.Fatalf("expected stopped iterator to report exhaustion")
+	}
+	stop()
+}
@@ -0,0 +1,182 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by protected tasks while the circuit is open or
+// while every half-open probe slot is taken.
+var ErrCircuitOpen = errors.New("task: circuit open")
+
+// BreakerConfig configures a CircuitBreaker. FailureThreshold consecutive
+// failures open the circuit; after Cooldown it turns half-open and admits up
+// to HalfOpenProbes trial runs, which must all succeed to close it again. Now
+// overrides the clock for deterministic tests.
+//
+// Example:
+//
+//	cfg := BreakerConfig{FailureThreshold: 5, Cooldown: 30 * time.Second, HalfOpenProbes: 2}
+type BreakerConfig struct {
+	Now              func() time.Time
+	Cooldown         time.Duration
+	FailureThreshold int
+	HalfOpenProbes   int
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// CircuitBreaker stops calling a failing dependency for a cooldown period. It
+// is safe for concurrent use.
+//
+// Example:
+//
+//	breaker := NewCircuitBreaker(BreakerConfig{FailureThreshold: 3, Cooldown: time.Minute})
+//	fetch := Protect(breaker, fetchUser)
+type CircuitBreaker struct {
+	openedAt       time.Time
+	now            func() time.Time
+	cooldown       time.Duration
+	threshold      int
+	probes         int
+	failures       int
+	probesInFlight int
+	probeSuccesses int
+	generation     uint64
+	state          breakerState
+	mu             sync.Mutex
+}
+
+// NewCircuitBreaker creates a closed CircuitBreaker. Non-positive thresholds and
+// probe counts are treated as 1, and a nil Now uses time.Now.
+//
+// Example:
+//
+//	breaker := NewCircuitBreaker(BreakerConfig{FailureThreshold: 5, Cooldown: 10 * time.Second})
+func NewCircuitBreaker(cfg BreakerConfig) *CircuitBreaker {
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
+	return &CircuitBreaker{
+		now:       now,
+		cooldown:  cfg.Cooldown,
+		threshold: max(cfg.FailureThreshold, 1),
+		probes:    max(cfg.HalfOpenProbes, 1),
+		state:     breakerClosed,
+	}
+}
+
+// breakerTicket identifies an admitted call. Probes carry the half-open
+// generation they were admitted in so results from an earlier window are
+// ignored once a new one has started.
+type breakerTicket struct {
+	generation uint64
+	probe      bool
+}
+
+func (b *CircuitBreaker) allow() (breakerTicket, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == breakerOpen {
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return breakerTicket{}, ErrCircuitOpen
+		}
+		b.state = breakerHalfOpen
+		b.generation++
+		b.probesInFlight = 0
+		b.probeSuccesses = 0
+	}
+	if b.state == breakerClosed {
+		return breakerTicket{}, nil
+	}
+	if b.probesInFlight+b.probeSuccesses >= b.probes {
+		return breakerTicket{}, ErrCircuitOpen
+	}
+	b.probesInFlight++
+	return breakerTicket{generation: b.generation, probe: true}, nil
+}
+
+func (b *CircuitBreaker) record(ticket breakerTicket, failed bool, skipped bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if ticket.probe {
+		if ticket.generation != b.generation {
+			return
+		}
+		b.probesInFlight--
+		if b.state != breakerHalfOpen || skipped {
+			return
+		}
+		if failed {
+			b.trip()
+			return
+		}
+		b.probeSuccesses++
+		if b.probeSuccesses >= b.probes {
+			b.state = breakerClosed
+			b.failures = 0
+		}
+		return
+	}
+	if b.state != breakerClosed || skipped {
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.trip()
+	}
+}
+
+func (b *CircuitBreaker) trip() {
+	b.state = breakerOpen
+	b.openedAt = b.now()
+	b.failures = 0
+}
+
+// Protect runs t through breaker. While the circuit is open it fails fast with
+// ErrCircuitOpen without running t. Context cancellation errors do not count
+// as failures, while a panic in t counts as a failure before it propagates.
+//
+// Example:
+//
+//	protected := Protect(breaker, callPaymentGateway)
+//	receipt, err := protected(ctx)
+//	if errors.Is(err, ErrCircuitOpen) {
+//		return queueForLater(ctx)
+//	}
+func Protect[T any](breaker *CircuitBreaker, t Task[T]) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+		ticket, err := breaker.allow()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		completed := false
+		defer func() {
+			if !completed {
+				breaker.record(ticket, true, false)
+			}
+		}()
+		value, err := t(ctx)
+		completed = true
+		breaker.record(ticket, err != nil, err != nil && isContextErr(ctx, err))
+		return value, err
+	}
+}
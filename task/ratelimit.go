@@ -0,0 +1,133 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/charmingruby/fgp/internal/timeutil"
+)
+
+// RateLimiter is a token bucket that refills at a fixed rate up to burst
+// tokens. It is safe for concurrent use.
+//
+// Example:
+//
+//	limiter := NewRateLimiter(10, 5) // 10 req/s, bursts of 5
+//	call := Limit(limiter, callAPI)
+type RateLimiter struct {
+	last   time.Time
+	now    func() time.Time
+	sleep  func(ctx context.Context, d time.Duration) bool
+	rate   float64
+	tokens float64
+	burst  float64
+	mu     sync.Mutex
+}
+
+// NewRateLimiter creates a RateLimiter that refills rate tokens per second up
+// to burst. The bucket starts full; non-positive bursts are treated as 1.
+//
+// Example:
+//
+//	limiter := NewRateLimiter(2, 1)
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return NewRateLimiterWithClock(rate, burst, time.Now, nil)
+}
+
+// NewRateLimiterWithClock behaves like NewRateLimiter but reads time from now
+// and waits for refills with sleep, which must return false when ctx is done
+// before d elapses. Injecting both makes Wait deterministic in tests: a fake
+// sleep can advance the fake clock instead of blocking. A nil sleep waits in
+// real time.
+//
+// Example:
+//
+//	limiter := NewRateLimiterWithClock(1, 1, fakeClock.Now, fakeClock.Sleep)
+func NewRateLimiterWithClock(
+	rate float64,
+	burst int,
+	now func() time.Time,
+	sleep func(ctx context.Context, d time.Duration) bool,
+) *RateLimiter {
+	if burst <= 0 {
+		burst = 1
+	}
+	if sleep == nil {
+		sleep = timeutil.Sleep
+	}
+	return &RateLimiter{
+		last:   now(),
+		now:    now,
+		sleep:  sleep,
+		rate:   rate,
+		tokens: float64(burst),
+		burst:  float64(burst),
+	}
+}
+
+// Wait blocks until a token is available and consumes it. When ctx is done
+// first it returns ctx.Err() without consuming a token. A non-positive rate
+// never refills, so callers wait for cancellation once the burst is spent.
+//
+// Example:
+//
+//	if err := limiter.Wait(ctx); err != nil {
+//		return err
+//	}
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		wait, ok := l.reserve()
+		if ok {
+			return nil
+		}
+		if wait <= 0 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		if !l.sleep(ctx, wait) {
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve consumes a token when available, otherwise it reports how long to
+// wait for the next one (zero when the bucket never refills). Waits saturate
+// instead of overflowing for tiny rates and never round down to zero.
+func (l *RateLimiter) reserve() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 && l.rate > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, true
+	}
+	if l.rate <= 0 {
+		return 0, false
+	}
+	return max(durationFromFloat((1-l.tokens)/l.rate*float64(time.Second)), time.Nanosecond), false
+}
+
+// Limit waits for a token from limiter before running t. Cancellation while
+// waiting returns ctx.Err() and leaves the bucket untouched.
+//
+// Example:
+//
+//	limited := Limit(limiter, callAPI)
+//	resp, err := limited(ctx)
+func Limit[T any](limiter *RateLimiter, t Task[T]) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		if err := limiter.Wait(ctx); err != nil {
+			var zero T
+			return zero, err
+		}
+		return t(ctx)
+	}
+}
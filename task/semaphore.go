@@ -0,0 +1,82 @@
+package task
+
+import "context"
+
+// Semaphore limits how many guarded tasks run at once across independent
+// executions, e.g. a global cap on outbound connections. It is safe for
+// concurrent use.
+//
+// Example:
+//
+//	outbound := NewSemaphore(8)
+//	fetch := Guard(outbound, fetchUser)
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// NewSemaphore creates a Semaphore with n slots. Non-positive values are
+// treated as 1.
+//
+// Example:
+//
+//	sem := NewSemaphore(4)
+func NewSemaphore(n int) *Semaphore {
+	if n <= 0 {
+		n = 1
+	}
+	return &Semaphore{slots: make(chan struct{}, n)}
+}
+
+// Acquire blocks until a slot is free or ctx is done, returning ctx.Err() in
+// the latter case.
+//
+// Example:
+//
+//	if err := sem.Acquire(ctx); err != nil {
+//		return err
+//	}
+//	defer sem.Release()
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s.slots <- struct{}{}:
+		return nil
+	}
+}
+
+// Release frees a slot previously obtained with Acquire. Calls must be paired
+// with a successful Acquire: an unmatched Release is a no-op when no slot is
+// held and never blocks, but while other callers hold slots it frees one of
+// theirs early.
+//
+// Example:
+//
+//	defer sem.Release()
+func (s *Semaphore) Release() {
+	select {
+	case <-s.slots:
+	default:
+	}
+}
+
+// Guard runs t only after acquiring a slot from sem and releases it once t
+// completes. Waiting for a slot respects context cancellation.
+//
+// Example:
+//
+//	limited := Guard(sem, fetchUser)
+//	user, err := limited(ctx)
+func Guard[T any](sem *Semaphore, t Task[T]) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		if err := sem.Acquire(ctx); err != nil {
+			var zero T
+			return zero, err
+		}
+		defer sem.Release()
+		return t(ctx)
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"sync"
 	"time"
 
@@ -25,6 +27,10 @@ import (
 
 var errRaceNoTasks = errors.New("task: race requires at least one task")
 var errParMapNilFn = errors.New("task: nil function for ParMapN")
+var errFirstSuccessNoTasks = errors.New("task: first success requires at least one task")
+var errChannelClosed = errors.New("task: channel closed before a value was received")
+var errScheduleInterval = errors.New("task: schedule interval must be positive")
+var errBothTimeout = fmt.Errorf("task: both timed out: %w", context.DeadlineExceeded)
 
 // Task represents a computation that can be executed with a context.
 //
@@ -130,6 +136,62 @@ func FlatMap[T any, U any](t Task[T], fn func(T) Task[U]) Task[U] {
 	}
 }
 
+// MapError rewrites the Task's failure with fn. Context cancellation errors are
+// returned untouched so cancellation still propagates.
+//
+// Example:
+//
+//	wrapped := MapError(fetchUser, func(err error) error {
+//		return fmt.Errorf("fetch user: %w", err)
+//	})
+func MapError[T any](t Task[T], fn func(error) error) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		val, err := t(ctx)
+		if err == nil || isContextErr(ctx, err) {
+			return val, err
+		}
+		return val, fn(err)
+	}
+}
+
+// Recover turns a failure into a success value computed by fn. Context
+// cancellation errors are returned untouched.
+//
+// Example:
+//
+//	withDefault := Recover(fetchSettings, func(error) Settings { return defaultSettings })
+func Recover[T any](t Task[T], fn func(error) T) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		val, err := t(ctx)
+		if err == nil || isContextErr(ctx, err) {
+			return val, err
+		}
+		return fn(err), nil
+	}
+}
+
+// FlatMapErr falls back to the Task returned by fn when t fails. Context
+// cancellation errors are returned untouched and fn is not invoked.
+//
+// Example:
+//
+//	withFallback := FlatMapErr(fetchFromPrimary, func(err error) Task[User] {
+//		return fetchFromSecondary
+//	})
+func FlatMapErr[T any](t Task[T], fn func(error) Task[T]) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		val, err := t(ctx)
+		if err == nil || isContextErr(ctx, err) {
+			return val, err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			var zero T
+			return zero, ctxErr
+		}
+		return fn(err)(ctx)
+	}
+}
+
 // Tap executes fn on success and passes the value through unchanged.
 //
 // Example:
@@ -164,6 +226,28 @@ func TapErr[T any](t Task[T], fn func(error)) Task[T] {
 	}
 }
 
+// Measure times the execution of t and reports the elapsed duration and error
+// to fn once it completes, passing the value through unchanged. Wrapping a
+// composed Task (e.g. Retry) measures the whole composition. A nil fn is a
+// no-op.
+//
+// Example:
+//
+//	timed := Measure(fetchUser, func(d time.Duration, err error) {
+//		metrics.Observe("user.fetch", d, err)
+//	})
+func Measure[T any](t Task[T], fn func(d time.Duration, err error)) Task[T] {
+	if fn == nil {
+		return t
+	}
+	return func(ctx context.Context) (T, error) {
+		start := time.Now()
+		value, err := t(ctx)
+		fn(time.Since(start), err)
+		return value, err
+	}
+}
+
 // Ensure runs fn after the task completes, regardless of success.
 //
 // Example:
@@ -177,6 +261,36 @@ func Ensure[T any](t Task[T], fn func()) Task[T] {
 	}
 }
 
+// Finalize runs each finalizer in order once the task completes, passing the
+// task's value and error. Finalizer errors are joined with the task error;
+// when the task succeeded but a finalizer fails, the value is discarded.
+//
+// Example:
+//
+//	withCleanup := Finalize(exportReport,
+//		func(ctx context.Context, _ Report, _ error) error { return tmpDir.Remove() },
+//		func(ctx context.Context, _ Report, err error) error { return audit.Record(ctx, err) },
+//	)
+func Finalize[T any](t Task[T], finalizers ...func(context.Context, T, error) error) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		value, err := t(ctx)
+		var finalizeErrs []error
+		for _, finalize := range finalizers {
+			if finalizeErr := finalize(ctx, value, err); finalizeErr != nil {
+				finalizeErrs = append(finalizeErrs, finalizeErr)
+			}
+		}
+		if len(finalizeErrs) == 0 {
+			return value, err
+		}
+		if err != nil {
+			return value, errors.Join(append([]error{err}, finalizeErrs...)...)
+		}
+		var zero T
+		return zero, errors.Join(finalizeErrs...)
+	}
+}
+
 // Bracket ensures that release runs after use, even when errors occur.
 //
 // Example:
@@ -189,6 +303,27 @@ func Bracket[A any, B any](
 	acquire Task[A],
 	use func(A) Task[B],
 	release func(context.Context, A, error) error,
+) Task[B] {
+	return BracketE(acquire, use, release, nil)
+}
+
+// BracketE extends Bracket by passing release failures through wrapRelease
+// before they are joined with the use error, so cleanup failures can be
+// annotated. A nil wrapRelease behaves exactly like Bracket. When wrapRelease
+// returns nil the original release error is kept, so cleanup failures are
+// never dropped.
+//
+// Example:
+//
+//	withConn := BracketE(acquireConn, useConn,
+//		func(ctx context.Context, conn *sql.Conn, err error) error { return conn.Close() },
+//		func(err error) error { return fmt.Errorf("failed to close connection: %w", err) },
+//	)
+func BracketE[A any, B any](
+	acquire Task[A],
+	use func(A) Task[B],
+	release func(context.Context, A, error) error,
+	wrapRelease func(error) error,
 ) Task[B] {
 	return func(ctx context.Context) (B, error) {
 		resource, err := acquire(ctx)
@@ -198,6 +333,11 @@ func Bracket[A any, B any](
 		}
 		value, useErr := use(resource)(ctx)
 		releaseErr := release(ctx, resource, useErr)
+		if releaseErr != nil && wrapRelease != nil {
+			if wrapped := wrapRelease(releaseErr); wrapped != nil {
+				releaseErr = wrapped
+			}
+		}
 		if releaseErr != nil {
 			if useErr != nil {
 				return value, errors.Join(useErr, releaseErr)
@@ -212,6 +352,165 @@ func Bracket[A any, B any](
 	}
 }
 
+// Memoize runs t at most once successfully and caches its value for later
+// calls. Concurrent callers wait for the in-flight run instead of starting
+// their own, giving up early if their context is done. Failures are not
+// cached, so a later call retries the underlying task.
+//
+// Example:
+//
+//	loadConfig := Memoize(From(readConfigFromDisk))
+//	cfg, err := loadConfig(ctx)
+func Memoize[T any](t Task[T]) Task[T] {
+	gate := make(chan struct{}, 1)
+	var cached T
+	var done bool
+	return func(ctx context.Context) (T, error) {
+		var zero T
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case gate <- struct{}{}:
+		}
+		defer func() { <-gate }()
+		if done {
+			return cached, nil
+		}
+		value, err := t(ctx)
+		if err != nil {
+			return zero, err
+		}
+		cached, done = value, true
+		return value, nil
+	}
+}
+
+// Dedupe returns a keyed deduplicator: concurrent runs sharing a key collapse
+// into one execution of the first task registered for that key, and every
+// caller receives its result. The shared run is detached from individual
+// callers, so one caller giving up does not cancel it for the others; it is
+// canceled only once every waiter has left. In-flight tracking is cleared on
+// completion so the next call executes again.
+//
+// Example:
+//
+//	dedupe := Dedupe[string, User]()
+//	load := func(id string) Task[User] {
+//		return dedupe(id, fetchUser(id))
+//	}
+func Dedupe[K comparable, T any]() func(key K, t Task[T]) Task[T] {
+	var mu sync.Mutex
+	calls := make(map[K]*dedupeCall[T])
+	return func(key K, t Task[T]) Task[T] {
+		return func(ctx context.Context) (T, error) {
+			var zero T
+			if err := ctx.Err(); err != nil {
+				return zero, err
+			}
+			mu.Lock()
+			call, ok := calls[key]
+			if !ok {
+				sharedCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+				call = &dedupeCall[T]{done: make(chan struct{}), cancel: cancel}
+				calls[key] = call
+				go func() {
+					value, err := t(sharedCtx)
+					mu.Lock()
+					call.value, call.err = value, err
+					if calls[key] == call {
+						delete(calls, key)
+					}
+					mu.Unlock()
+					cancel()
+					close(call.done)
+				}()
+			}
+			call.waiters++
+			mu.Unlock()
+			select {
+			case <-call.done:
+				return call.value, call.err
+			case <-ctx.Done():
+				mu.Lock()
+				call.waiters--
+				if call.waiters == 0 {
+					call.cancel()
+					if calls[key] == call {
+						delete(calls, key)
+					}
+				}
+				mu.Unlock()
+				return zero, ctx.Err()
+			}
+		}
+	}
+}
+
+type dedupeCall[T any] struct {
+	value   T
+	err     error
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+}
+
+// BracketAll acquires every resource in order, runs use with all of them, and
+// releases them in reverse order. When an acquire fails, the resources already
+// acquired are still released (receiving the acquire error), and acquire, use,
+// and release errors are joined with errors.Join like Bracket.
+//
+// Example:
+//
+//	withConns := BracketAll([]Task[*sql.Conn]{acquirePrimary, acquireReplica},
+//		func(conns []*sql.Conn) Task[Report] { return compare(conns[0], conns[1]) },
+//		func(ctx context.Context, conn *sql.Conn, err error) error { return conn.Close() },
+//	)
+func BracketAll[A any, B any](
+	acquires []Task[A],
+	use func([]A) Task[B],
+	release func(context.Context, A, error) error,
+) Task[B] {
+	return func(ctx context.Context) (B, error) {
+		var zero B
+		resources := make([]A, 0, len(acquires))
+		for _, acquire := range acquires {
+			resource, err := acquire(ctx)
+			if err != nil {
+				releaseErrs := releaseAll(ctx, resources, release, err)
+				return zero, errors.Join(append([]error{err}, releaseErrs...)...)
+			}
+			resources = append(resources, resource)
+		}
+		value, useErr := use(resources)(ctx)
+		releaseErrs := releaseAll(ctx, resources, release, useErr)
+		if len(releaseErrs) == 0 {
+			return value, useErr
+		}
+		if useErr != nil {
+			return value, errors.Join(append([]error{useErr}, releaseErrs...)...)
+		}
+		return zero, errors.Join(releaseErrs...)
+	}
+}
+
+func releaseAll[A any](
+	ctx context.Context,
+	resources []A,
+	release func(context.Context, A, error) error,
+	cause error,
+) []error {
+	var errs []error
+	for i := len(resources) - 1; i >= 0; i-- {
+		if err := release(ctx, resources[i], cause); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
 // Timeout bounds the execution time of a Task.
 //
 // Example:
@@ -228,19 +527,137 @@ func Timeout[T any](t Task[T], d time.Duration) Task[T] {
 	}
 }
 
-// RetryConfig defines retry behavior for Retry.
+// ClassifyCancel rewrites context failures by cause: errors matching
+// context.DeadlineExceeded go through onDeadline and those matching
+// context.Canceled through onCancel. Other errors and nil handlers leave the
+// error untouched.
+//
+// Example:
+//
+//	classified := ClassifyCancel(fetchUser,
+//		func(err error) error { return fmt.Errorf("%w: %w", errRetryable, err) },
+//		nil,
+//	)
+func ClassifyCancel[T any](t Task[T], onDeadline, onCancel func(error) error) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		value, err := t(ctx)
+		switch {
+		case err == nil:
+			return value, nil
+		case errors.Is(err, context.DeadlineExceeded) && onDeadline != nil:
+			return value, onDeadline(err)
+		case errors.Is(err, context.Canceled) && onCancel != nil:
+			return value, onCancel(err)
+		default:
+			return value, err
+		}
+	}
+}
+
+// TimeoutErr behaves like Timeout but returns err instead of the raw context
+// error when its own deadline fires before t finishes. Parent cancellation and
+// parent deadlines still propagate unchanged. A nil err falls back to
+// context.DeadlineExceeded.
+//
+// Example:
+//
+//	errEnrichTimeout := errors.New("enrich stage timed out")
+//	bounded := TimeoutErr(enrichOrder, 200*time.Millisecond, errEnrichTimeout)
+func TimeoutErr[T any](t Task[T], d time.Duration, err error) Task[T] {
+	if d <= 0 {
+		return t
+	}
+	timeoutErr := err
+	if timeoutErr == nil {
+		timeoutErr = context.DeadlineExceeded
+	}
+	return func(ctx context.Context) (T, error) {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+		value, taskErr := t(ctxWithTimeout)
+		if taskErr != nil && ctx.Err() == nil && errors.Is(ctxWithTimeout.Err(), context.DeadlineExceeded) {
+			return value, timeoutErr
+		}
+		return value, taskErr
+	}
+}
+
+// RetryConfig defines retry behavior for Retry. AttemptTimeout bounds each
+// individual attempt when positive; zero leaves attempts unbounded.
+// MaxElapsedTime caps the wall-clock budget of the whole loop when positive;
+// Now overrides the clock it is measured against and defaults to time.Now.
 //
 // Example:
 //
-//	cfg := RetryConfig{Attempts: 3, Delay: 100 * time.Millisecond}
+//	cfg := RetryConfig{Attempts: 3, Delay: 100 * time.Millisecond, AttemptTimeout: time.Second}
 type RetryConfig struct { //nolint:govet // fieldalignment: keep numeric fields grouped for readability
-	Attempts    int
-	Delay       time.Duration
-	Backoff     func(attempt int, err error) time.Duration
-	ShouldRetry func(error) bool
+	Now            func() time.Time
+	Attempts       int
+	Delay          time.Duration
+	AttemptTimeout time.Duration
+	MaxElapsedTime time.Duration
+	Backoff        func(attempt int, err error) time.Duration
+	ShouldRetry    func(error) bool
+}
+
+// ExponentialBackoff returns a RetryConfig.Backoff computing
+// base*factor^(attempt-1), capped at maxDelay when positive. jitter in [0,1]
+// randomly shaves up to that fraction off each delay: 0 disables jitter and 1
+// yields full jitter. Randomness comes from the concurrency-safe math/rand/v2
+// source; use ExponentialBackoffRand for deterministic tests.
+//
+// Example:
+//
+//	cfg := RetryConfig{
+//		Attempts: 5,
+//		Backoff:  ExponentialBackoff(100*time.Millisecond, 2, 5*time.Second, 0.5),
+//	}
+func ExponentialBackoff(
+	base time.Duration,
+	factor float64,
+	maxDelay time.Duration,
+	jitter float64,
+) func(attempt int, err error) time.Duration {
+	return ExponentialBackoffRand(base, factor, maxDelay, jitter, rand.Float64)
+}
+
+// ExponentialBackoffRand behaves like ExponentialBackoff but draws jitter from
+// randFloat, which must return values in [0,1).
+//
+// Example:
+//
+//	backoff := ExponentialBackoffRand(time.Second, 2, time.Minute, 1, func() float64 { return 0.5 })
+func ExponentialBackoffRand(
+	base time.Duration,
+	factor float64,
+	maxDelay time.Duration,
+	jitter float64,
+	randFloat func() float64,
+) func(attempt int, err error) time.Duration {
+	jitter = math.Min(math.Max(jitter, 0), 1)
+	return func(attempt int, _ error) time.Duration {
+		if attempt < 1 {
+			attempt = 1
+		}
+		delay := float64(base) * math.Pow(factor, float64(attempt-1))
+		if maxDelay > 0 && delay > float64(maxDelay) {
+			delay = float64(maxDelay)
+		}
+		if delay >= float64(math.MaxInt64) {
+			delay = float64(math.MaxInt64)
+		}
+		if jitter > 0 && randFloat != nil {
+			delay -= delay * jitter * randFloat()
+		}
+		return durationFromFloat(delay)
+	}
 }
 
-// Retry re-executes the task according to cfg when it fails.
+// Retry re-executes the task according to cfg when it fails. An attempt that
+// exceeds cfg.AttemptTimeout counts as a retryable failure, while cancellation
+// of the parent context aborts the whole loop. When the elapsed time plus the
+// next delay would exceed cfg.MaxElapsedTime, Retry stops and returns the last
+// error even if attempts remain.
 //
 // Example:
 //
@@ -251,6 +668,11 @@ func Retry[T any](t Task[T], cfg RetryConfig) Task[T] { //nolint:gocognit // bra
 		if attempts <= 0 {
 			attempts = 1
 		}
+		now := cfg.Now
+		if now == nil {
+			now = time.Now
+		}
+		start := now()
 		var lastErr error
 		var value T
 		for attempt := 1; attempt <= attempts; attempt++ {
@@ -258,22 +680,23 @@ func Retry[T any](t Task[T], cfg RetryConfig) Task[T] { //nolint:gocognit // bra
 				var zero T
 				return zero, err
 			}
-			value, lastErr = t(ctx)
+			value, lastErr = runAttempt(ctx, t, cfg.AttemptTimeout)
 			if lastErr == nil {
 				return value, nil
 			}
+			if err := ctx.Err(); err != nil {
+				var zero T
+				return zero, err
+			}
 			if cfg.ShouldRetry != nil && !cfg.ShouldRetry(lastErr) {
 				break
 			}
 			if attempt == attempts {
 				break
 			}
-			delay := cfg.Delay
-			if cfg.Backoff != nil {
-				delay = cfg.Backoff(attempt, lastErr)
-			}
-			if delay < 0 {
-				delay = 0
+			delay := retryDelay(cfg, attempt, lastErr)
+			if cfg.MaxElapsedTime > 0 && now().Sub(start)+delay > cfg.MaxElapsedTime {
+				break
 			}
 			if !timeutil.Sleep(ctx, delay) {
 				var zero T
@@ -285,6 +708,67 @@ func Retry[T any](t Task[T], cfg RetryConfig) Task[T] { //nolint:gocognit // bra
 	}
 }
 
+// RetryByClass re-executes the task using the policy classify selects for each
+// observed error. Attempts are counted across the whole run, so a policy allows
+// another try only while the total is below its Attempts; a zero-attempt
+// policy stops retrying immediately.
+//
+// Example:
+//
+//	withRetry := RetryByClass(callAPI, func(err error) RetryConfig {
+//		if errors.Is(err, context.DeadlineExceeded) {
+//			return RetryConfig{Attempts: 5, Delay: 100 * time.Millisecond}
+//		}
+//		return RetryConfig{}
+//	})
+func RetryByClass[T any](t Task[T], classify func(error) RetryConfig) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		var zero T
+		for attempt := 1; ; attempt++ {
+			if err := ctx.Err(); err != nil {
+				return zero, err
+			}
+			value, err := t(ctx)
+			if err == nil {
+				return value, nil
+			}
+			if classify == nil {
+				return zero, err
+			}
+			cfg := classify(err)
+			if attempt >= cfg.Attempts {
+				return zero, err
+			}
+			if cfg.ShouldRetry != nil && !cfg.ShouldRetry(err) {
+				return zero, err
+			}
+			if !timeutil.Sleep(ctx, retryDelay(cfg, attempt, err)) {
+				return zero, ctx.Err()
+			}
+		}
+	}
+}
+
+func runAttempt[T any](ctx context.Context, t Task[T], timeout time.Duration) (T, error) {
+	if timeout <= 0 {
+		return t(ctx)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return t(attemptCtx)
+}
+
+func retryDelay(cfg RetryConfig, attempt int, err error) time.Duration {
+	delay := cfg.Delay
+	if cfg.Backoff != nil {
+		delay = cfg.Backoff(attempt, err)
+	}
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
+
 // Sequence runs tasks sequentially.
 //
 // Example:
@@ -321,6 +805,28 @@ func SequencePar[T any](tasks []Task[T]) Task[[]T] {
 	})
 }
 
+// AllSettled runs every task concurrently and reports each outcome as a Result
+// in input order. Failures never cancel siblings; the returned Task only fails
+// when the parent context is canceled.
+//
+// Example:
+//
+//	outcomes, err := AllSettled([]Task[Widget]{loadSales, loadTraffic, loadErrors})(ctx)
+func AllSettled[T any](tasks []Task[T]) Task[[]result.Result[T]] {
+	return AllSettledN(tasks, len(tasks))
+}
+
+// AllSettledN behaves like AllSettled but runs at most n tasks at a time.
+//
+// Example:
+//
+//	outcomes, err := AllSettledN(healthChecks, 4)(ctx)
+func AllSettledN[T any](tasks []Task[T], n int) Task[[]result.Result[T]] {
+	return TraverseParNSettled(tasks, n, func(t Task[T]) Task[T] {
+		return t
+	})
+}
+
 // Race runs tasks concurrently and returns the first completed result, canceling
 // the remaining tasks. When all tasks fail it returns the last error observed.
 //
@@ -392,6 +898,65 @@ func awaitRaceResult[T any](
 	return zero, ctx.Err()
 }
 
+// FirstSuccess runs tasks concurrently and returns the first successful value,
+// canceling the rest. Unlike Race, a fast failure never wins over a slower
+// success; it fails only when every task fails, joining all their errors.
+//
+// Example:
+//
+//	hedged := FirstSuccess(fetchFromPrimary, fetchFromReplica)
+func FirstSuccess[T any](tasks ...Task[T]) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		var zero T
+		if len(tasks) == 0 {
+			return zero, errFirstSuccessNoTasks
+		}
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		raceCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		outcomes := make(chan raceOutcome[T], len(tasks))
+		startRaceWorkers(raceCtx, tasks, outcomes)
+		errs := make([]error, 0, len(tasks))
+		for range tasks {
+			select {
+			case <-ctx.Done():
+				return zero, ctx.Err()
+			case outcome := <-outcomes:
+				if outcome.err == nil {
+					return outcome.value, nil
+				}
+				errs = append(errs, outcome.err)
+			}
+		}
+		return zero, errors.Join(errs...)
+	}
+}
+
+// RaceWithIndex behaves like FirstSuccess but also reports the zero-based
+// argument index of the winning task, which is useful for hedged-request
+// metrics. It fails with the joined errors when every task fails.
+//
+// Example:
+//
+//	winner, err := RaceWithIndex(fetchFromPrimary, fetchFromReplica)(ctx)
+//	if err == nil {
+//		metrics.Count("hedge.winner", winner.First)
+//	}
+func RaceWithIndex[T any](tasks ...Task[T]) Task[result.Tuple2[int, T]] {
+	if len(tasks) == 0 {
+		return Fail[result.Tuple2[int, T]](errRaceNoTasks)
+	}
+	indexed := make([]Task[result.Tuple2[int, T]], len(tasks))
+	for i, t := range tasks {
+		indexed[i] = Map(t, func(value T) result.Tuple2[int, T] {
+			return result.Tuple2[int, T]{First: i, Second: value}
+		})
+	}
+	return FirstSuccess(indexed...)
+}
+
 // ParZip executes two tasks concurrently and returns their results preserving
 // ordering. If either fails, the other is canceled.
 //
@@ -411,32 +976,8 @@ func ParZip[A any, B any](left Task[A], right Task[B]) Task[result.Tuple2[A, B]]
 		var leftVal A
 		var rightVal B
 		wg.Add(2)
-		go func() {
-			defer wg.Done()
-			value, err := left(ctx)
-			if err != nil {
-				select {
-				case errCh <- err:
-				default:
-				}
-				cancel()
-				return
-			}
-			leftVal = value
-		}()
-		go func() {
-			defer wg.Done()
-			value, err := right(ctx)
-			if err != nil {
-				select {
-				case errCh <- err:
-				default:
-				}
-				cancel()
-				return
-			}
-			rightVal = value
-		}()
+		go runZipped(ctx, cancel, &wg, errCh, left, &leftVal)
+		go runZipped(ctx, cancel, &wg, errCh, right, &rightVal)
 		wg.Wait()
 		if err := pullError(errCh); err != nil {
 			return zero, err
@@ -448,6 +989,81 @@ func ParZip[A any, B any](left Task[A], right Task[B]) Task[result.Tuple2[A, B]]
 	}
 }
 
+// BothTimeout runs both tasks concurrently under a shared budget d, canceling
+// both when it elapses. A budget overrun returns an error wrapping
+// context.DeadlineExceeded; parent cancellation propagates unchanged. A
+// non-positive d is an already expired budget, so neither task runs.
+//
+// Example:
+//
+//	pair, err := BothTimeout(loadUser, loadProfile, 200*time.Millisecond)(ctx)
+func BothTimeout[A any, B any](left Task[A], right Task[B], d time.Duration) Task[result.Tuple2[A, B]] {
+	if d <= 0 {
+		return func(ctx context.Context) (result.Tuple2[A, B], error) {
+			if err := ctx.Err(); err != nil {
+				return result.Tuple2[A, B]{}, err
+			}
+			return result.Tuple2[A, B]{}, errBothTimeout
+		}
+	}
+	return TimeoutErr(ParZip(left, right), d, errBothTimeout)
+}
+
+// ParZip3 executes three tasks concurrently and returns their results
+// preserving ordering. If any fails, the others are canceled.
+//
+// Example:
+//
+//	combined := ParZip3(loadUser, loadProfile, loadSettings)
+func ParZip3[A any, B any, C any](first Task[A], second Task[B], third Task[C]) Task[result.Tuple3[A, B, C]] {
+	return func(ctx context.Context) (result.Tuple3[A, B, C], error) {
+		var zero result.Tuple3[A, B, C]
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		errCh := make(chan error, 3)
+		var wg sync.WaitGroup
+		var firstVal A
+		var secondVal B
+		var thirdVal C
+		wg.Add(3)
+		go runZipped(ctx, cancel, &wg, errCh, first, &firstVal)
+		go runZipped(ctx, cancel, &wg, errCh, second, &secondVal)
+		go runZipped(ctx, cancel, &wg, errCh, third, &thirdVal)
+		wg.Wait()
+		if err := pullError(errCh); err != nil {
+			return zero, err
+		}
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		return result.Tuple3[A, B, C]{First: firstVal, Second: secondVal, Third: thirdVal}, nil
+	}
+}
+
+func runZipped[T any](
+	ctx context.Context,
+	cancel context.CancelFunc,
+	wg *sync.WaitGroup,
+	errCh chan<- error,
+	t Task[T],
+	out *T,
+) {
+	defer wg.Done()
+	value, err := t(ctx)
+	if err != nil {
+		select {
+		case errCh <- err:
+		default:
+		}
+		cancel()
+		return
+	}
+	*out = value
+}
+
 // Both executes two tasks concurrently and returns their results as a tuple.
 //
 // Example:
@@ -457,6 +1073,15 @@ func Both[A any, B any](left Task[A], right Task[B]) Task[result.Tuple2[A, B]] {
 	return ParZip(left, right)
 }
 
+// Both3 executes three tasks concurrently and returns their results as a tuple.
+//
+// Example:
+//
+//	all := Both3(taskA, taskB, taskC)
+func Both3[A any, B any, C any](first Task[A], second Task[B], third Task[C]) Task[result.Tuple3[A, B, C]] {
+	return ParZip3(first, second, third)
+}
+
 // ParMapN applies fn to each element concurrently with at most n workers.
 //
 // Example:
@@ -490,6 +1115,87 @@ func Delay(d time.Duration) Task[struct{}] {
 	}
 }
 
+// Schedule runs t repeatedly, waiting interval after each run finishes so
+// executions never overlap, and reports every outcome to fn. It runs until the
+// context is canceled and then returns ctx.Err(). A non-positive interval
+// would spin without pausing, so it fails immediately without running t.
+//
+// Example:
+//
+//	refresher := Schedule(loadFeatureFlags, time.Minute, func(res result.Result[Flags]) {
+//		if flags, err := res.Unwrap(); err == nil {
+//			store.Set(flags)
+//		}
+//	})
+//	go func() { _, _ = refresher(ctx) }()
+func Schedule[T any](t Task[T], interval time.Duration, fn func(result.Result[T])) Task[struct{}] {
+	return func(ctx context.Context) (struct{}, error) {
+		var done struct{}
+		if interval <= 0 {
+			return done, errScheduleInterval
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				return done, err
+			}
+			outcome := result.FromTuple(t(ctx))
+			if fn != nil {
+				fn(outcome)
+			}
+			if !timeutil.Sleep(ctx, interval) {
+				return done, ctx.Err()
+			}
+		}
+	}
+}
+
+// Ticker returns a starter that emits an incrementing tick count (starting at
+// 1) every period until ctx is done or the returned stop function is called.
+// The channel is closed once the ticker goroutine exits; stop blocks until then.
+// A non-positive period yields an already closed channel.
+//
+// Example:
+//
+//	ticks, stop := Ticker(time.Second)(ctx)
+//	defer stop()
+//	for n := range ticks {
+//		log.Println("tick", n)
+//	}
+func Ticker(period time.Duration) func(ctx context.Context) (<-chan int, func()) {
+	return func(ctx context.Context) (<-chan int, func()) {
+		ticks := make(chan int)
+		if period <= 0 {
+			close(ticks)
+			return ticks, func() {}
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer close(ticks)
+			ticker := time.NewTicker(period)
+			defer ticker.Stop()
+			for count := 1; ; count++ {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case ticks <- count:
+				}
+			}
+		}()
+		stop := func() {
+			cancel()
+			<-done
+		}
+		return ticks, stop
+	}
+}
+
 // Attempt executes t and converts panics into errors to avoid crashing callers.
 //
 // Example:
@@ -573,6 +1279,96 @@ func TraverseParN[A any, B any](items []A, n int, fn func(A) Task[B]) Task[[]B]
 	}
 }
 
+// TraverseParNSettled runs fn for every item with at most n workers and
+// reports each outcome as a Result in input order. Failures never cancel
+// siblings; only parent context cancellation aborts the traversal.
+//
+// Example:
+//
+//	outcomes, err := TraverseParNSettled(rows, 8, importRow)(ctx)
+//	for i, outcome := range outcomes {
+//		if outcome.IsErr() {
+//			log.Printf("row %d: %v", i, outcome.Err())
+//		}
+//	}
+func TraverseParNSettled[A any, B any](items []A, n int, fn func(A) Task[B]) Task[[]result.Result[B]] {
+	return func(ctx context.Context) ([]result.Result[B], error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return []result.Result[B]{}, nil
+		}
+		workers := clampParallelism(len(items), n)
+		outcomes := make([]result.Result[B], len(items))
+		jobs := make(chan workItem[A], len(items))
+		var wg sync.WaitGroup
+		wg.Add(workers)
+		for range workers {
+			go func() {
+				defer wg.Done()
+				for job := range jobs {
+					outcomes[job.index] = result.FromTuple(fn(job.item)(ctx))
+				}
+			}()
+		}
+		enqueueWork(ctx, jobs, items)
+		close(jobs)
+		wg.Wait()
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return outcomes, nil
+	}
+}
+
+// TraverseParNPartial reshapes TraverseParNSettled into parallel slices: each
+// slot holds Some(value) or None alongside a nil or non-nil error, so batch
+// jobs can keep every completed value after partial failures.
+//
+// Example:
+//
+//	partial, err := TraverseParNPartial(ids, 4, fetchUserByID)(ctx)
+//	users, errs := partial.First, partial.Second
+func TraverseParNPartial[A any, B any](
+	items []A,
+	n int,
+	fn func(A) Task[B],
+) Task[result.Tuple2[[]option.Option[B], []error]] {
+	type partial = result.Tuple2[[]option.Option[B], []error]
+	return Map(TraverseParNSettled(items, n, fn), func(outcomes []result.Result[B]) partial {
+		values := make([]option.Option[B], len(outcomes))
+		errs := make([]error, len(outcomes))
+		for i, outcome := range outcomes {
+			values[i] = option.OkOption(outcome)
+			errs[i] = outcome.Err()
+		}
+		return partial{First: values, Second: errs}
+	})
+}
+
+// TraverseParNTimed behaves like TraverseParN but pairs each value with how long
+// its task took, preserving input order and the concurrency bound.
+//
+// Example:
+//
+//	timed, err := TraverseParNTimed(urls, 4, fetchURL)(ctx)
+//	for _, entry := range timed {
+//		log.Println("fetched in", entry.Second)
+//	}
+func TraverseParNTimed[A any, B any](items []A, n int, fn func(A) Task[B]) Task[[]result.Tuple2[B, time.Duration]] {
+	return TraverseParN(items, n, func(item A) Task[result.Tuple2[B, time.Duration]] {
+		return func(ctx context.Context) (result.Tuple2[B, time.Duration], error) {
+			start := time.Now()
+			value, err := fn(item)(ctx)
+			if err != nil {
+				return result.Tuple2[B, time.Duration]{}, err
+			}
+			return result.Tuple2[B, time.Duration]{First: value, Second: time.Since(start)}, nil
+		}
+	})
+}
+
 type workItem[T any] struct { //nolint:govet // fieldalignment: generic payload size dominates; keep simple layout
 	index int
 	item  T
@@ -607,6 +1403,106 @@ func pullError(errCh <-chan error) error {
 	}
 }
 
+// FromChannel builds a Task that receives one value from ch, failing when ch is
+// closed first or the context is canceled while waiting.
+//
+// Example:
+//
+//	next := FromChannel(events)
+//	event, err := next(ctx)
+func FromChannel[T any](ch <-chan T) Task[T] {
+	return func(ctx context.Context) (T, error) {
+		var zero T
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case value, ok := <-ch:
+			if !ok {
+				return zero, errChannelClosed
+			}
+			return value, nil
+		}
+	}
+}
+
+// ToChannel runs tasks sequentially and sends each outcome to out as a Result
+// in input order. Task failures are delivered, not returned; the Task only
+// fails when the context is canceled. The caller owns out and closes it.
+//
+// Example:
+//
+//	outcomes := make(chan result.Result[Report])
+//	go func() {
+//		defer close(outcomes)
+//		_, _ = ToChannel(reportTasks, outcomes)(ctx)
+//	}()
+func ToChannel[T any](tasks []Task[T], out chan<- result.Result[T]) Task[struct{}] {
+	return func(ctx context.Context) (struct{}, error) {
+		var done struct{}
+		for _, t := range tasks {
+			if err := ctx.Err(); err != nil {
+				return done, err
+			}
+			if !sendOutcome(ctx, out, result.FromTuple(t(ctx))) {
+				return done, ctx.Err()
+			}
+		}
+		return done, ctx.Err()
+	}
+}
+
+// ToChannelAsCompleted runs tasks with at most n workers and sends each outcome
+// to out as soon as it finishes, so ordering follows completion rather than
+// input. Once ctx is canceled, workers stop picking up tasks and abandon
+// pending sends. It waits for every worker before returning. The caller owns
+// out and closes it.
+//
+// Example:
+//
+//	_, err := ToChannelAsCompleted(probes, 4, outcomes)(ctx)
+func ToChannelAsCompleted[T any](tasks []Task[T], n int, out chan<- result.Result[T]) Task[struct{}] {
+	return func(ctx context.Context) (struct{}, error) {
+		var done struct{}
+		if err := ctx.Err(); err != nil {
+			return done, err
+		}
+		if len(tasks) == 0 {
+			return done, nil
+		}
+		workers := clampParallelism(len(tasks), n)
+		jobs := make(chan workItem[Task[T]], len(tasks))
+		var wg sync.WaitGroup
+		wg.Add(workers)
+		for range workers {
+			go func() {
+				defer wg.Done()
+				for job := range jobs {
+					if ctx.Err() != nil {
+						continue
+					}
+					sendOutcome(ctx, out, result.FromTuple(job.item(ctx)))
+				}
+			}()
+		}
+		enqueueWork(ctx, jobs, tasks)
+		close(jobs)
+		wg.Wait()
+		return done, ctx.Err()
+	}
+}
+
+func sendOutcome[T any](ctx context.Context, out chan<- result.Result[T], outcome result.Result[T]) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case out <- outcome:
+		return true
+	}
+}
+
 // FromResult lifts an existing Result into a Task. Context cancellation takes
 // precedence over the stored error.
 //
@@ -651,6 +1547,17 @@ func FromOption[T any](opt option.Option[T], errFactory func() error) Task[T] {
 	}
 }
 
+// Run executes t immediately and wraps its outcome in a Result. Unlike
+// ToResultTask, every failure, including context cancellation, becomes Err.
+//
+// Example:
+//
+//	res := Run(ctx, fetchUser)
+//	return result.Fold(res, renderError, renderUser)
+func Run[T any](ctx context.Context, t Task[T]) result.Result[T] {
+	return result.FromTuple(t(ctx))
+}
+
 // ToResultTask converts a Task into one that never fails (except for context
 // cancellation) and instead wraps the outcome in a Result.
 //
@@ -665,7 +1572,7 @@ func ToResultTask[T any](t Task[T]) Task[result.Result[T]] {
 	return func(ctx context.Context) (result.Result[T], error) {
 		val, err := t(ctx)
 		if err != nil {
-			if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+			if isContextErr(ctx, err) {
 				return result.Result[T]{}, err
 			}
 			return result.Err[T](err), nil
@@ -673,3 +1580,23 @@ func ToResultTask[T any](t Task[T]) Task[result.Result[T]] {
 		return result.Ok(val), nil
 	}
 }
+
+func isContextErr(ctx context.Context, err error) bool {
+	ctxErr := ctx.Err()
+	return ctxErr != nil && errors.Is(err, ctxErr)
+}
+
+// durationFromFloat converts nanoseconds held in a float64 into a Duration,
+// saturating at the representable range instead of overflowing. float64 cannot
+// represent math.MaxInt64 exactly, so the comparison must happen before the
+// conversion.
+func durationFromFloat(ns float64) time.Duration {
+	switch {
+	case math.IsNaN(ns) || ns <= 0:
+		return 0
+	case ns >= float64(math.MaxInt64):
+		return time.Duration(math.MaxInt64)
+	default:
+		return time.Duration(ns)
+	}
+}
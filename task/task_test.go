@@ -3,6 +3,10 @@ package task_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -269,6 +273,1141 @@ func TestInteropHelpers(t *testing.T) {
 	}
 }
 
+func TestRetryByClass(t *testing.T) {
+	transient := errors.New("transient")
+	fatal := errors.New("fatal")
+	classify := func(err error) task.RetryConfig {
+		if errors.Is(err, transient) {
+			return task.RetryConfig{Attempts: 5, Delay: time.Millisecond}
+		}
+		return task.RetryConfig{}
+	}
+	var attempts atomic.Int32
+	flaky := task.From(func(_ context.Context) (int, error) {
+		if attempts.Add(1) < 3 {
+			return 0, transient
+		}
+		return 3, nil
+	})
+	value, err := task.RetryByClass(flaky, classify)(context.Background())
+	if err != nil || value != 3 || attempts.Load() != 3 {
+		t.Fatalf("unexpected retry by class result %v %v after %d attempts", value, err, attempts.Load())
+	}
+	attempts.Store(0)
+	broken := task.From(func(_ context.Context) (int, error) {
+		attempts.Add(1)
+		return 0, fatal
+	})
+	_, err = task.RetryByClass(broken, classify)(context.Background())
+	if !errors.Is(err, fatal) || attempts.Load() != 1 {
+		t.Fatalf("expected fail fast on fatal error, got %v after %d attempts", err, attempts.Load())
+	}
+}
+
+func TestFinalizeRunsInOrderAndJoinsErrors(t *testing.T) {
+	var order []string
+	record := func(name string, err error) func(context.Context, int, error) error {
+		return func(context.Context, int, error) error {
+			order = append(order, name)
+			return err
+		}
+	}
+	value, err := task.Finalize(task.Pure(1), record("first", nil), record("second", nil))(context.Background())
+	if err != nil || value != 1 || len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Fatalf("unexpected finalize output %v %v %v", value, err, order)
+	}
+	useErr := errors.New("use failed")
+	cleanupErr := errors.New("cleanup failed")
+	order = nil
+	_, err = task.Finalize(task.Fail[int](useErr), record("first", cleanupErr), record("second", nil))(
+		context.Background(),
+	)
+	if !errors.Is(err, useErr) || !errors.Is(err, cleanupErr) || len(order) != 2 {
+		t.Fatalf("expected joined errors after all finalizers, got %v %v", err, order)
+	}
+	_, err = task.Finalize(task.Pure(1), record("only", cleanupErr))(context.Background())
+	if !errors.Is(err, cleanupErr) {
+		t.Fatalf("expected finalizer error on success path, got %v", err)
+	}
+}
+
+func TestTickerEmitsAndStops(t *testing.T) {
+	receive := func(ticks <-chan int) (int, bool) {
+		t.Helper()
+		select {
+		case n, ok := <-ticks:
+			return n, ok
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting on ticker channel")
+			return 0, false
+		}
+	}
+	ticks, stop := task.Ticker(2 * time.Millisecond)(context.Background())
+	for want := 1; want <= 3; want++ {
+		if got, _ := receive(ticks); got != want {
+			t.Fatalf("expected tick %d, got %d", want, got)
+		}
+	}
+	stop()
+	if _, ok := receive(ticks); ok {
+		t.Fatalf("expected channel closed after stop")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks, stop = task.Ticker(time.Hour)(ctx)
+	defer stop()
+	cancel()
+	if _, ok := receive(ticks); ok {
+		t.Fatalf("expected channel closed after context cancel")
+	}
+}
+
+func TestMemoizeRunsOnceAndRetriesFailures(t *testing.T) {
+	var calls atomic.Int32
+	loader := task.From(func(_ context.Context) (int, error) {
+		if calls.Add(1) == 1 {
+			return 0, errors.New("cold start")
+		}
+		time.Sleep(2 * time.Millisecond)
+		return 42, nil
+	})
+	memo := task.Memoize(loader)
+	if _, err := memo(context.Background()); err == nil {
+		t.Fatalf("expected first failure to surface")
+	}
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if value, err := memo(context.Background()); err != nil || value != 42 {
+				t.Errorf("unexpected memoized output %v %v", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 2 {
+		t.Fatalf("expected one failed and one successful run, got %d", calls.Load())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := memo(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context error to take precedence, got %v", err)
+	}
+}
+
+func TestDedupeCollapsesConcurrentCalls(t *testing.T) {
+	dedupe := task.Dedupe[string, int]()
+	var runs atomic.Int32
+	release := make(chan struct{})
+	load := task.From(func(_ context.Context) (int, error) {
+		runs.Add(1)
+		<-release
+		return 99, nil
+	})
+	const callers = 50
+	var started sync.WaitGroup
+	var wg sync.WaitGroup
+	for range callers {
+		started.Add(1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Done()
+			if value, err := dedupe("user:1", load)(context.Background()); err != nil || value != 99 {
+				t.Errorf("unexpected deduped output %v %v", value, err)
+			}
+		}()
+	}
+	started.Wait()
+	time.Sleep(5 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if runs.Load() != 1 {
+		t.Fatalf("expected a single underlying run, got %d", runs.Load())
+	}
+	if value, err := dedupe("user:1", load)(context.Background()); err != nil || value != 99 || runs.Load() != 2 {
+		t.Fatalf("expected re-execution after completion, got %v %v runs=%d", value, err, runs.Load())
+	}
+}
+
+func TestDedupeCallerCancelDoesNotCancelOthers(t *testing.T) {
+	dedupe := task.Dedupe[string, int]()
+	release := make(chan struct{})
+	slow := task.From(func(ctx context.Context) (int, error) {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-release:
+			return 1, nil
+		}
+	})
+	impatient, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := dedupe("k", slow)(impatient)
+		errCh <- err
+	}()
+	time.Sleep(2 * time.Millisecond)
+	resultCh := make(chan int, 1)
+	go func() {
+		value, _ := dedupe("k", slow)(context.Background())
+		resultCh <- value
+	}()
+	time.Sleep(2 * time.Millisecond)
+	cancel()
+	if err := <-errCh; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled caller to return context error, got %v", err)
+	}
+	close(release)
+	if value := <-resultCh; value != 1 {
+		t.Fatalf("expected remaining caller to get shared result, got %d", value)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	plain := task.ExponentialBackoff(10*time.Millisecond, 2, 50*time.Millisecond, 0)
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 50 * time.Millisecond}
+	for i, expected := range want {
+		if got := plain(i+1, nil); got != expected {
+			t.Fatalf("attempt %d: expected %v, got %v", i+1, expected, got)
+		}
+	}
+	half := task.ExponentialBackoffRand(time.Second, 2, 0, 0.5, func() float64 { return 1 })
+	if got := half(2, nil); got != time.Second {
+		t.Fatalf("expected proportional jitter to halve delay, got %v", got)
+	}
+	full := task.ExponentialBackoff(time.Second, 3, 0, 1)
+	for attempt := 1; attempt <= 5; attempt++ {
+		if got := full(attempt, nil); got < 0 || got > time.Second*time.Duration(math.Pow(3, float64(attempt-1))) {
+			t.Fatalf("full jitter out of bounds at attempt %d: %v", attempt, got)
+		}
+	}
+}
+
+func TestExponentialBackoffSaturates(t *testing.T) {
+	uncapped := task.ExponentialBackoff(time.Second, 2, 0, 0)
+	for _, attempt := range []int{35, 40, 64, 100, 2000} {
+		if got := uncapped(attempt, nil); got != time.Duration(math.MaxInt64) {
+			t.Fatalf("attempt %d: expected saturated delay, got %v", attempt, got)
+		}
+	}
+	jittered := task.ExponentialBackoffRand(time.Second, 2, 0, 1, func() float64 { return 1e-20 })
+	if got := jittered(2000, nil); got <= 0 {
+		t.Fatalf("expected positive delay after jitter, got %v", got)
+	}
+}
+
+func TestClassifyCancel(t *testing.T) {
+	errRetryable := errors.New("retryable")
+	errTerminal := errors.New("terminal")
+	blocking := task.From(func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	classified := task.ClassifyCancel(blocking,
+		func(err error) error { return errors.Join(errRetryable, err) },
+		func(err error) error { return errors.Join(errTerminal, err) },
+	)
+	deadlineCtx, cancelDeadline := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelDeadline()
+	if _, err := classified(deadlineCtx); !errors.Is(err, errRetryable) {
+		t.Fatalf("expected deadline mapped to retryable, got %v", err)
+	}
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(time.Millisecond)
+		cancel()
+	}()
+	if _, err := classified(cancelCtx); !errors.Is(err, errTerminal) {
+		t.Fatalf("expected cancel mapped to terminal, got %v", err)
+	}
+	boom := errors.New("boom")
+	_, err := task.ClassifyCancel(task.Fail[int](boom), nil, nil)(context.Background())
+	if !errors.Is(err, boom) || errors.Is(err, errRetryable) {
+		t.Fatalf("expected non-context error untouched, got %v", err)
+	}
+}
+
+func TestRetryAttemptTimeout(t *testing.T) {
+	var attempts atomic.Int32
+	work := task.From(func(ctx context.Context) (int, error) {
+		if attempts.Add(1) < 3 {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		return 5, nil
+	})
+	retried := task.Retry(work, task.RetryConfig{Attempts: 3, AttemptTimeout: 2 * time.Millisecond})
+	value, err := retried(context.Background())
+	if err != nil || value != 5 || attempts.Load() != 3 {
+		t.Fatalf("expected timed out attempts to be retried, got %v %v after %d", value, err, attempts.Load())
+	}
+	parent, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	attempts.Store(0)
+	hanging := task.From(func(ctx context.Context) (int, error) {
+		attempts.Add(1)
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	_, err = task.Retry(hanging, task.RetryConfig{Attempts: 100, AttemptTimeout: time.Second})(parent)
+	if !errors.Is(err, context.DeadlineExceeded) || attempts.Load() != 1 {
+		t.Fatalf("expected parent deadline to abort loop, got %v after %d", err, attempts.Load())
+	}
+}
+
+func TestAllSettled(t *testing.T) {
+	boom := errors.New("boom")
+	tasks := []task.Task[int]{task.Pure(1), task.Fail[int](boom), task.Pure(3)}
+	outcomes, err := task.AllSettled(tasks)(context.Background())
+	if err != nil || len(outcomes) != 3 {
+		t.Fatalf("unexpected all settled output %v %v", outcomes, err)
+	}
+	if outcomes[0].UnwrapOr(0) != 1 || !errors.Is(outcomes[1].Err(), boom) || outcomes[2].UnwrapOr(0) != 3 {
+		t.Fatalf("unexpected outcomes %v", outcomes)
+	}
+	var inFlight atomic.Int32
+	var peak atomic.Int32
+	tracked := make([]task.Task[int], 6)
+	for i := range tracked {
+		tracked[i] = task.From(func(_ context.Context) (int, error) {
+			updatePeak(&peak, inFlight.Add(1))
+			time.Sleep(2 * time.Millisecond)
+			inFlight.Add(-1)
+			return i, nil
+		})
+	}
+	if _, err := task.AllSettledN(tracked, 2)(context.Background()); err != nil || peak.Load() > 2 {
+		t.Fatalf("expected bounded concurrency, got peak %d err %v", peak.Load(), err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := task.AllSettled(tasks)(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected parent cancellation error, got %v", err)
+	}
+}
+
+func TestFirstSuccessPrefersSlowerSuccess(t *testing.T) {
+	fastFailure := task.Fail[int](errors.New("fast failure"))
+	slowSuccess := task.From(func(ctx context.Context) (int, error) {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(5 * time.Millisecond):
+			return 2, nil
+		}
+	})
+	value, err := task.FirstSuccess(fastFailure, slowSuccess)(context.Background())
+	if err != nil || value != 2 {
+		t.Fatalf("expected slow success to win, got %v %v", value, err)
+	}
+	errA := errors.New("a")
+	errB := errors.New("b")
+	_, err = task.FirstSuccess(task.Fail[int](errA), task.Fail[int](errB))(context.Background())
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("expected joined errors, got %v", err)
+	}
+	if _, err := task.FirstSuccess[int]()(context.Background()); err == nil {
+		t.Fatalf("expected error when no tasks provided")
+	}
+}
+
+func TestTraverseParNTimed(t *testing.T) {
+	items := []int{3, 1, 2}
+	fn := func(v int) task.Task[int] {
+		return task.From(func(_ context.Context) (int, error) {
+			time.Sleep(time.Duration(v) * time.Millisecond)
+			return v * 2, nil
+		})
+	}
+	timed, err := task.TraverseParNTimed(items, 2, fn)(context.Background())
+	if err != nil || len(timed) != len(items) {
+		t.Fatalf("unexpected timed traversal %v %v", timed, err)
+	}
+	for i, v := range items {
+		if timed[i].First != v*2 || timed[i].Second <= 0 {
+			t.Fatalf("unexpected entry at %d: %+v", i, timed[i])
+		}
+	}
+}
+
+func TestParZip3AndBoth3(t *testing.T) {
+	triple, err := task.ParZip3(task.Pure(1), task.Pure("two"), task.Pure(3.0))(context.Background())
+	if err != nil || triple.First != 1 || triple.Second != "two" || triple.Third != 3.0 {
+		t.Fatalf("unexpected parzip3 result %v %v", triple, err)
+	}
+	boom := errors.New("boom")
+	blocked := task.From(func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	_, err = task.Both3(blocked, task.Fail[string](boom), task.Pure(true))(context.Background())
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected sibling cancellation with original error, got %v", err)
+	}
+}
+
+func TestMapErrorAndRecover(t *testing.T) {
+	boom := errors.New("boom")
+	wrapped := task.MapError(task.Fail[int](boom), func(err error) error {
+		return fmt.Errorf("wrapped: %w", err)
+	})
+	if _, err := wrapped(context.Background()); !errors.Is(err, boom) || err.Error() != "wrapped: boom" {
+		t.Fatalf("unexpected mapped error %v", err)
+	}
+	recovered, err := task.Recover(task.Fail[int](boom), func(error) int { return 7 })(context.Background())
+	if err != nil || recovered != 7 {
+		t.Fatalf("unexpected recover output %v %v", recovered, err)
+	}
+	untouched, err := task.Recover(task.Pure(1), func(error) int { return 7 })(context.Background())
+	if err != nil || untouched != 1 {
+		t.Fatalf("expected success untouched, got %v %v", untouched, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	_, err = task.Recover(task.Fail[int](boom), func(error) int {
+		calls++
+		return 7
+	})(ctx)
+	if !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Fatalf("expected cancellation to bypass recover, got %v", err)
+	}
+	_, err = task.MapError(task.Fail[int](boom), func(error) error {
+		calls++
+		return boom
+	})(ctx)
+	if !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Fatalf("expected cancellation to bypass map error, got %v", err)
+	}
+}
+
+func TestFlatMapErr(t *testing.T) {
+	boom := errors.New("primary down")
+	var seen error
+	fallback := func(err error) task.Task[string] {
+		seen = err
+		return task.Pure("secondary")
+	}
+	value, err := task.FlatMapErr(task.Fail[string](boom), fallback)(context.Background())
+	if err != nil || value != "secondary" || !errors.Is(seen, boom) {
+		t.Fatalf("unexpected fallback output %v %v", value, err)
+	}
+	value, err = task.FlatMapErr(task.Pure("primary"), fallback)(context.Background())
+	if err != nil || value != "primary" {
+		t.Fatalf("expected success passthrough, got %v %v", value, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	seen = nil
+	failing := task.Task[string](func(context.Context) (string, error) { return "", boom })
+	if _, err := task.FlatMapErr(failing, fallback)(ctx); !errors.Is(err, context.Canceled) || seen != nil {
+		t.Fatalf("expected canceled context to skip recovery, got %v", err)
+	}
+}
+
+func TestTraverseParNSettled(t *testing.T) {
+	errOdd := errors.New("odd")
+	var inFlight atomic.Int32
+	var peak atomic.Int32
+	fn := func(v int) task.Task[int] {
+		return task.From(func(_ context.Context) (int, error) {
+			updatePeak(&peak, inFlight.Add(1))
+			defer inFlight.Add(-1)
+			time.Sleep(time.Millisecond)
+			if v%2 != 0 {
+				return 0, errOdd
+			}
+			return v * 10, nil
+		})
+	}
+	items := []int{1, 2, 3, 4, 5}
+	outcomes, err := task.TraverseParNSettled(items, 2, fn)(context.Background())
+	if err != nil || len(outcomes) != len(items) {
+		t.Fatalf("unexpected settled traversal %v %v", outcomes, err)
+	}
+	for i, v := range items {
+		if v%2 != 0 {
+			if !errors.Is(outcomes[i].Err(), errOdd) {
+				t.Fatalf("expected error at %d, got %v", i, outcomes[i])
+			}
+			continue
+		}
+		if outcomes[i].UnwrapOr(0) != v*10 {
+			t.Fatalf("unexpected value at %d: %v", i, outcomes[i])
+		}
+	}
+	if peak.Load() > 2 {
+		t.Fatalf("expected concurrency <= 2, got %d", peak.Load())
+	}
+}
+
+func TestSemaphoreUnmatchedRelease(t *testing.T) {
+	sem := task.NewSemaphore(1)
+	released := make(chan struct{})
+	go func() {
+		sem.Release()
+		sem.Release()
+		close(released)
+	}()
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatalf("unmatched release blocked")
+	}
+	ctx := context.Background()
+	if err := sem.Acquire(ctx); err != nil {
+		t.Fatalf("unexpected acquire error %v", err)
+	}
+	short, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	if err := sem.Acquire(short); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected capacity to stay at one, got %v", err)
+	}
+}
+
+func TestSemaphoreGuardLimitsConcurrency(t *testing.T) {
+	sem := task.NewSemaphore(2)
+	var inFlight atomic.Int32
+	var peak atomic.Int32
+	work := task.From(func(_ context.Context) (int, error) {
+		updatePeak(&peak, inFlight.Add(1))
+		time.Sleep(2 * time.Millisecond)
+		inFlight.Add(-1)
+		return 1, nil
+	})
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := task.Guard(sem, work)(context.Background()); err != nil {
+				t.Errorf("unexpected guard error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if peak.Load() > 2 {
+		t.Fatalf("expected concurrency <= 2, got %d", peak.Load())
+	}
+	if err := sem.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected acquire error: %v", err)
+	}
+	if err := sem.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected acquire error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Millisecond)
+	defer cancel()
+	if _, err := task.Guard(sem, work)(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context error while waiting, got %v", err)
+	}
+}
+
+func TestRateLimiterRefillsAndHonorsCancellation(t *testing.T) {
+	var clock atomic.Int64
+	start := time.Unix(0, 0)
+	now := func() time.Time { return start.Add(time.Duration(clock.Load())) }
+	sleeps := 0
+	var lastWait time.Duration
+	var cancelWaiter context.CancelFunc
+	cancelOnSleep := func(_ context.Context, d time.Duration) bool {
+		sleeps++
+		lastWait = d
+		cancelWaiter()
+		return false
+	}
+	limiter := task.NewRateLimiterWithClock(10, 2, now, cancelOnSleep)
+	work := task.Pure(1)
+	for range 2 {
+		if _, err := task.Limit(limiter, work)(context.Background()); err != nil {
+			t.Fatalf("expected burst token, got %v", err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cancelWaiter = cancel
+	if _, err := task.Limit(limiter, work)(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context error while waiting, got %v", err)
+	}
+	if sleeps != 1 || lastWait != 100*time.Millisecond {
+		t.Fatalf("expected one wait for a refill, got %d waits of %v", sleeps, lastWait)
+	}
+	clock.Add(int64(100 * time.Millisecond))
+	if _, err := task.Limit(limiter, work)(context.Background()); err != nil || sleeps != 1 {
+		t.Fatalf("expected refilled token without waiting, got %v after %d waits", err, sleeps)
+	}
+	emptied, cancelEmptied := context.WithCancel(context.Background())
+	defer cancelEmptied()
+	cancelWaiter = cancelEmptied
+	if err := limiter.Wait(emptied); !errors.Is(err, context.Canceled) || sleeps != 2 {
+		t.Fatalf("expected bucket to be empty again, got %v after %d waits", err, sleeps)
+	}
+}
+
+func TestRateLimiterInjectedSleep(t *testing.T) {
+	var clock atomic.Int64
+	start := time.Unix(0, 0)
+	now := func() time.Time { return start.Add(time.Duration(clock.Load())) }
+	advance := func(_ context.Context, d time.Duration) bool {
+		clock.Add(int64(d))
+		return true
+	}
+	limiter := task.NewRateLimiterWithClock(10, 1, now, advance)
+	for range 3 {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected wait error %v", err)
+		}
+	}
+	if got := time.Duration(clock.Load()); got != 200*time.Millisecond {
+		t.Fatalf("expected fake clock to advance by two refills, got %v", got)
+	}
+}
+
+func TestRateLimiterTinyRateSaturatesWait(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var requested time.Duration
+	stop := func(_ context.Context, d time.Duration) bool {
+		requested = d
+		cancel()
+		return false
+	}
+	limiter := task.NewRateLimiterWithClock(1e-10, 1, time.Now, stop)
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("expected burst token, got %v", err)
+	}
+	if err := limiter.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation while waiting, got %v", err)
+	}
+	if requested != time.Duration(math.MaxInt64) {
+		t.Fatalf("expected saturated wait, got %v", requested)
+	}
+}
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	var clock atomic.Int64
+	start := time.Unix(0, 0)
+	breaker := task.NewCircuitBreaker(task.BreakerConfig{
+		FailureThreshold: 2,
+		Cooldown:         time.Second,
+		HalfOpenProbes:   1,
+		Now:              func() time.Time { return start.Add(time.Duration(clock.Load())) },
+	})
+	boom := errors.New("boom")
+	var runs atomic.Int32
+	healthy := atomic.Bool{}
+	protected := task.Protect(breaker, task.From(func(_ context.Context) (int, error) {
+		runs.Add(1)
+		if healthy.Load() {
+			return 1, nil
+		}
+		return 0, boom
+	}))
+	ctx := context.Background()
+	for range 2 {
+		if _, err := protected(ctx); !errors.Is(err, boom) {
+			t.Fatalf("expected underlying failure, got %v", err)
+		}
+	}
+	if _, err := protected(ctx); !errors.Is(err, task.ErrCircuitOpen) || runs.Load() != 2 {
+		t.Fatalf("expected open circuit without running, got %v after %d runs", err, runs.Load())
+	}
+	clock.Add(int64(time.Second))
+	if _, err := protected(ctx); !errors.Is(err, boom) {
+		t.Fatalf("expected half-open probe to run, got %v", err)
+	}
+	if _, err := protected(ctx); !errors.Is(err, task.ErrCircuitOpen) {
+		t.Fatalf("expected failed probe to reopen circuit, got %v", err)
+	}
+	clock.Add(int64(time.Second))
+	healthy.Store(true)
+	if value, err := protected(ctx); err != nil || value != 1 {
+		t.Fatalf("expected successful probe, got %v %v", value, err)
+	}
+	if value, err := protected(ctx); err != nil || value != 1 {
+		t.Fatalf("expected closed circuit after probe success, got %v %v", value, err)
+	}
+}
+
+func TestCircuitBreakerPanickingProbe(t *testing.T) {
+	var clock atomic.Int64
+	start := time.Unix(0, 0)
+	breaker := task.NewCircuitBreaker(task.BreakerConfig{
+		FailureThreshold: 1,
+		Cooldown:         time.Second,
+		HalfOpenProbes:   1,
+		Now:              func() time.Time { return start.Add(time.Duration(clock.Load())) },
+	})
+	var panicking atomic.Bool
+	protected := task.Protect(breaker, task.From(func(_ context.Context) (int, error) {
+		if panicking.Load() {
+			panic("probe exploded")
+		}
+		return 0, errors.New("boom")
+	}))
+	ctx := context.Background()
+	if _, err := protected(ctx); err == nil {
+		t.Fatalf("expected failure to open circuit")
+	}
+	clock.Add(int64(time.Second))
+	panicking.Store(true)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected probe panic to propagate")
+			}
+		}()
+		_, _ = protected(ctx)
+	}()
+	if _, err := protected(ctx); !errors.Is(err, task.ErrCircuitOpen) {
+		t.Fatalf("expected panic to count as failure and reopen circuit, got %v", err)
+	}
+	clock.Add(int64(time.Second))
+	panicking.Store(false)
+	if _, err := protected(ctx); errors.Is(err, task.ErrCircuitOpen) {
+		t.Fatalf("expected probe slot to be released after cooldown, got %v", err)
+	}
+}
+
+func TestCircuitBreakerIgnoresStaleProbes(t *testing.T) {
+	var clock atomic.Int64
+	start := time.Unix(0, 0)
+	breaker := task.NewCircuitBreaker(task.BreakerConfig{
+		FailureThreshold: 1,
+		Cooldown:         time.Second,
+		HalfOpenProbes:   2,
+		Now:              func() time.Time { return start.Add(time.Duration(clock.Load())) },
+	})
+	ctx := context.Background()
+	failing := task.Protect(breaker, task.Fail[int](errors.New("boom")))
+	healthy := task.Protect(breaker, task.Pure(1))
+	slowProbe := func() (chan struct{}, <-chan error) {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		done := make(chan error, 1)
+		probe := task.Protect(breaker, task.From(func(_ context.Context) (int, error) {
+			close(started)
+			<-release
+			return 1, nil
+		}))
+		go func() {
+			_, err := probe(ctx)
+			done <- err
+		}()
+		<-started
+		return release, done
+	}
+	_, _ = failing(ctx)
+	clock.Add(int64(time.Second))
+	releaseStale, staleDone := slowProbe()
+	if _, err := failing(ctx); errors.Is(err, task.ErrCircuitOpen) {
+		t.Fatalf("expected second probe to run and trip the circuit")
+	}
+	clock.Add(int64(time.Second))
+	releaseCurrent, currentDone := slowProbe()
+	close(releaseStale)
+	if err := <-staleDone; err != nil {
+		t.Fatalf("unexpected stale probe error %v", err)
+	}
+	if _, err := healthy(ctx); err != nil {
+		t.Fatalf("expected second probe slot of the new window, got %v", err)
+	}
+	if _, err := healthy(ctx); !errors.Is(err, task.ErrCircuitOpen) {
+		t.Fatalf("expected stale success to be ignored while a probe is in flight, got %v", err)
+	}
+	close(releaseCurrent)
+	if err := <-currentDone; err != nil {
+		t.Fatalf("unexpected current probe error %v", err)
+	}
+	if _, err := healthy(ctx); err != nil {
+		t.Fatalf("expected circuit to close after current probes succeed, got %v", err)
+	}
+}
+
+func TestBothTimeout(t *testing.T) {
+	pair, err := task.BothTimeout(task.Pure(1), task.Pure("a"), 50*time.Millisecond)(context.Background())
+	if err != nil || pair.First != 1 || pair.Second != "a" {
+		t.Fatalf("unexpected in-budget result %v %v", pair, err)
+	}
+	var canceled atomic.Int32
+	slow := task.From(func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		canceled.Add(1)
+		return 0, ctx.Err()
+	})
+	_, err = task.BothTimeout(slow, slow, 2*time.Millisecond)(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	if canceled.Load() != 2 {
+		t.Fatalf("expected both tasks canceled, got %d", canceled.Load())
+	}
+}
+
+func TestBothTimeoutNonPositiveBudget(t *testing.T) {
+	var runs atomic.Int32
+	work := task.From(func(_ context.Context) (int, error) {
+		return int(runs.Add(1)), nil
+	})
+	for _, budget := range []time.Duration{0, -time.Second} {
+		_, err := task.BothTimeout(work, work, budget)(context.Background())
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected expired budget for %v, got %v", budget, err)
+		}
+	}
+	if runs.Load() != 0 {
+		t.Fatalf("expected no task to run, got %d runs", runs.Load())
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := task.BothTimeout(work, work, 0)(canceled); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected parent cancellation to take precedence, got %v", err)
+	}
+}
+
+func TestChannelAdapters(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 5
+	if value, err := task.FromChannel(ch)(context.Background()); err != nil || value != 5 {
+		t.Fatalf("unexpected from channel output %v %v", value, err)
+	}
+	close(ch)
+	if _, err := task.FromChannel(ch)(context.Background()); err == nil {
+		t.Fatalf("expected error on closed channel")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := task.FromChannel(make(chan int))(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation while waiting, got %v", err)
+	}
+
+	boom := errors.New("boom")
+	tasks := []task.Task[int]{task.Pure(1), task.Fail[int](boom), task.Pure(3)}
+	out := make(chan result.Result[int], len(tasks))
+	if _, err := task.ToChannel(tasks, out)(context.Background()); err != nil {
+		t.Fatalf("unexpected to channel error: %v", err)
+	}
+	first, second, third := <-out, <-out, <-out
+	if first.UnwrapOr(0) != 1 || !errors.Is(second.Err(), boom) || third.UnwrapOr(0) != 3 {
+		t.Fatalf("expected input ordering, got %v %v %v", first, second, third)
+	}
+
+	unordered := make(chan result.Result[int], len(tasks))
+	if _, err := task.ToChannelAsCompleted(tasks, 2, unordered)(context.Background()); err != nil {
+		t.Fatalf("unexpected as-completed error: %v", err)
+	}
+	close(unordered)
+	oks, errs := 0, 0
+	for outcome := range unordered {
+		if outcome.IsOk() {
+			oks++
+			continue
+		}
+		errs++
+	}
+	if oks != 2 || errs != 1 {
+		t.Fatalf("expected every outcome delivered, got ok=%d err=%d", oks, errs)
+	}
+}
+
+func TestToChannelAsCompletedBoundsConcurrency(t *testing.T) {
+	var inFlight atomic.Int32
+	var peak atomic.Int32
+	tasks := make([]task.Task[int], 8)
+	for i := range tasks {
+		tasks[i] = task.From(func(_ context.Context) (int, error) {
+			updatePeak(&peak, inFlight.Add(1))
+			defer inFlight.Add(-1)
+			time.Sleep(2 * time.Millisecond)
+			return i, nil
+		})
+	}
+	out := make(chan result.Result[int], len(tasks))
+	if _, err := task.ToChannelAsCompleted(tasks, 3, out)(context.Background()); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(out) != len(tasks) {
+		t.Fatalf("expected every outcome delivered, got %d", len(out))
+	}
+	if peak.Load() > 3 {
+		t.Fatalf("expected at most 3 concurrent tasks, got %d", peak.Load())
+	}
+}
+
+func TestToChannelAsCompletedCancelMidStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var started atomic.Int32
+	tasks := make([]task.Task[int], 6)
+	for i := range tasks {
+		tasks[i] = task.From(func(_ context.Context) (int, error) {
+			started.Add(1)
+			return i, nil
+		})
+	}
+	out := make(chan result.Result[int])
+	finished := make(chan error, 1)
+	go func() {
+		_, err := task.ToChannelAsCompleted(tasks, 2, out)(ctx)
+		finished <- err
+	}()
+	select {
+	case <-out:
+	case <-time.After(time.Second):
+		t.Fatalf("expected a first outcome")
+	}
+	cancel()
+	select {
+	case err := <-finished:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected cancellation error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("workers stayed blocked sending outcomes after cancellation")
+	}
+	if started.Load() > 3 {
+		t.Fatalf("expected no new tasks after cancellation, got %d started", started.Load())
+	}
+}
+
+func TestScheduleRunsUntilCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var runs atomic.Int32
+	var inFlight atomic.Int32
+	work := task.From(func(_ context.Context) (int, error) {
+		if inFlight.Add(1) > 1 {
+			t.Errorf("schedule overlapped executions")
+		}
+		defer inFlight.Add(-1)
+		return int(runs.Add(1)), nil
+	})
+	var seen atomic.Int32
+	_, err := task.Schedule(work, time.Millisecond, func(res result.Result[int]) {
+		if res.IsOk() && seen.Add(1) == 3 {
+			cancel()
+		}
+	})(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation error, got %v", err)
+	}
+	if runs.Load() != 3 || seen.Load() != 3 {
+		t.Fatalf("expected three runs, got %d runs and %d callbacks", runs.Load(), seen.Load())
+	}
+}
+
+func TestScheduleRejectsNonPositiveInterval(t *testing.T) {
+	var runs atomic.Int32
+	work := task.From(func(_ context.Context) (int, error) {
+		return int(runs.Add(1)), nil
+	})
+	for _, interval := range []time.Duration{0, -time.Second} {
+		_, err := task.Schedule(work, interval, nil)(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "interval must be positive") {
+			t.Fatalf("expected interval error for %v, got %v", interval, err)
+		}
+	}
+	if runs.Load() != 0 {
+		t.Fatalf("expected task not to run, got %d runs", runs.Load())
+	}
+}
+
+func TestTimeoutErr(t *testing.T) {
+	errStage := errors.New("stage timed out")
+	blocking := task.From(func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	if _, err := task.TimeoutErr(blocking, time.Millisecond, errStage)(context.Background()); !errors.Is(err, errStage) {
+		t.Fatalf("expected custom timeout error, got %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(time.Millisecond)
+		cancel()
+	}()
+	if _, err := task.TimeoutErr(blocking, time.Second, errStage)(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected parent cancellation to propagate, got %v", err)
+	}
+	value, err := task.TimeoutErr(task.Pure(3), time.Second, errStage)(context.Background())
+	if err != nil || value != 3 {
+		t.Fatalf("unexpected in-time result %v %v", value, err)
+	}
+}
+
+func TestBracketEWrapsReleaseError(t *testing.T) {
+	useErr := errors.New("use failed")
+	closeErr := errors.New("close failed")
+	release := func(context.Context, int, error) error { return closeErr }
+	wrap := func(err error) error { return fmt.Errorf("failed to close connection: %w", err) }
+	_, err := task.BracketE(task.Pure(1), func(int) task.Task[int] {
+		return task.Fail[int](useErr)
+	}, release, wrap)(context.Background())
+	if !errors.Is(err, useErr) || !errors.Is(err, closeErr) {
+		t.Fatalf("expected joined errors, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "failed to close connection: close failed") {
+		t.Fatalf("expected wrapped release error, got %v", err)
+	}
+	_, err = task.BracketE(task.Pure(1), func(int) task.Task[int] {
+		return task.Pure(2)
+	}, release, nil)(context.Background())
+	if !errors.Is(err, closeErr) || err.Error() != "close failed" {
+		t.Fatalf("expected bracket behavior with nil wrapper, got %v", err)
+	}
+	swallow := func(error) error { return nil }
+	value, err := task.BracketE(task.Pure(1), func(int) task.Task[int] {
+		return task.Pure(2)
+	}, release, swallow)(context.Background())
+	if !errors.Is(err, closeErr) || value != 0 {
+		t.Fatalf("expected original release error when wrapper returns nil, got %v %v", value, err)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	var elapsed time.Duration
+	var observed error
+	boom := errors.New("boom")
+	slowFail := task.From(func(_ context.Context) (int, error) {
+		time.Sleep(2 * time.Millisecond)
+		return 4, boom
+	})
+	value, err := task.Measure(slowFail, func(d time.Duration, err error) {
+		elapsed = d
+		observed = err
+	})(context.Background())
+	if value != 4 || !errors.Is(err, boom) {
+		t.Fatalf("expected passthrough, got %v %v", value, err)
+	}
+	if elapsed < 2*time.Millisecond || !errors.Is(observed, boom) {
+		t.Fatalf("unexpected measurement %v %v", elapsed, observed)
+	}
+	if value, err := task.Measure(task.Pure(1), nil)(context.Background()); err != nil || value != 1 {
+		t.Fatalf("expected nil fn to be a no-op, got %v %v", value, err)
+	}
+}
+
+func TestRaceWithIndex(t *testing.T) {
+	slow := task.From(func(ctx context.Context) (string, error) {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(20 * time.Millisecond):
+			return "slow", nil
+		}
+	})
+	failing := task.Fail[string](errors.New("fast failure"))
+	winner, err := task.RaceWithIndex(failing, slow, task.Pure("fast"))(context.Background())
+	if err != nil || winner.First != 2 || winner.Second != "fast" {
+		t.Fatalf("unexpected winner %+v %v", winner, err)
+	}
+	errA := errors.New("a")
+	errB := errors.New("b")
+	_, err = task.RaceWithIndex(task.Fail[int](errA), task.Fail[int](errB))(context.Background())
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("expected joined errors, got %v", err)
+	}
+	_, raceErr := task.Race[int]()(context.Background())
+	_, err = task.RaceWithIndex[int]()(context.Background())
+	if !errors.Is(err, raceErr) {
+		t.Fatalf("expected race sentinel on empty input, got %v", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	if res := task.Run(context.Background(), task.Pure(3)); res.UnwrapOr(0) != 3 {
+		t.Fatalf("expected ok result, got %v", res)
+	}
+	boom := errors.New("boom")
+	if res := task.Run(context.Background(), task.Fail[int](boom)); !errors.Is(res.Err(), boom) {
+		t.Fatalf("expected err result, got %v", res.Err())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if res := task.Run(ctx, task.Pure(3)); !errors.Is(res.Err(), context.Canceled) {
+		t.Fatalf("expected canceled context as err result, got %v", res.Err())
+	}
+}
+
+func TestTraverseParNPartial(t *testing.T) {
+	boom := errors.New("boom")
+	fn := func(v int) task.Task[int] {
+		if v == 2 {
+			return task.Fail[int](boom)
+		}
+		return task.Pure(v * 10)
+	}
+	partial, err := task.TraverseParNPartial([]int{1, 2, 3}, 2, fn)(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected traversal error: %v", err)
+	}
+	values, errs := partial.First, partial.Second
+	if values[0].GetOrElse(0) != 10 || values[1].IsSome() || values[2].GetOrElse(0) != 30 {
+		t.Fatalf("unexpected partial values %v", values)
+	}
+	if errs[0] != nil || !errors.Is(errs[1], boom) || errs[2] != nil {
+		t.Fatalf("unexpected partial errors %v", errs)
+	}
+}
+
+func TestBracketAllReleasesOnPartialAcquire(t *testing.T) {
+	acquireErr := errors.New("third acquire failed")
+	releaseErr := errors.New("release 1 failed")
+	var released []int
+	release := func(_ context.Context, id int, _ error) error {
+		released = append(released, id)
+		if id == 1 {
+			return releaseErr
+		}
+		return nil
+	}
+	useCalled := false
+	use := func([]int) task.Task[int] {
+		useCalled = true
+		return task.Pure(0)
+	}
+	acquires := []task.Task[int]{task.Pure(1), task.Pure(2), task.Fail[int](acquireErr)}
+	_, err := task.BracketAll(acquires, use, release)(context.Background())
+	if !errors.Is(err, acquireErr) || !errors.Is(err, releaseErr) || useCalled {
+		t.Fatalf("expected joined acquire and release errors without use, got %v", err)
+	}
+	if len(released) != 2 || released[0] != 2 || released[1] != 1 {
+		t.Fatalf("expected reverse release of acquired resources, got %v", released)
+	}
+	released = nil
+	sum := func(ids []int) task.Task[int] { return task.Pure(ids[0] + ids[1] + ids[2]) }
+	value, err := task.BracketAll([]task.Task[int]{task.Pure(3), task.Pure(4), task.Pure(5)}, sum, release)(
+		context.Background(),
+	)
+	if err != nil || value != 12 || len(released) != 3 || released[0] != 5 {
+		t.Fatalf("unexpected bracket all output %v %v released=%v", value, err, released)
+	}
+}
+
+func TestRetryMaxElapsedTime(t *testing.T) {
+	boom := errors.New("boom")
+	var clock atomic.Int64
+	start := time.Unix(0, 0)
+	var attempts atomic.Int32
+	work := task.From(func(_ context.Context) (int, error) {
+		attempts.Add(1)
+		clock.Add(int64(4 * time.Second))
+		return 0, boom
+	})
+	cfg := task.RetryConfig{
+		Attempts:       10,
+		Delay:          time.Nanosecond,
+		MaxElapsedTime: 10 * time.Second,
+		Now:            func() time.Time { return start.Add(time.Duration(clock.Load())) },
+	}
+	_, err := task.Retry(work, cfg)(context.Background())
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected last error, got %v", err)
+	}
+	if attempts.Load() != 3 {
+		t.Fatalf("expected budget to stop after 3 attempts, got %d", attempts.Load())
+	}
+}
+
 func updatePeak(peak *atomic.Int32, value int32) {
 	for {
 		old := peak.Load()
@@ -8,6 +8,7 @@ package validated
 import (
 	"errors"
 
+	"github.com/charmingruby/fgp/option"
 	"github.com/charmingruby/fgp/result"
 )
 
@@ -32,6 +33,15 @@ func Invalid[E any, T any](errs ...E) Validated[E, T] {
 	return Validated[E, T]{errors: copyErrs}
 }
 
+// FromPredicate returns Valid(value) when predicate passes, otherwise an
+// Invalid carrying onFail(value).
+func FromPredicate[E any, T any](value T, predicate func(T) bool, onFail func(T) E) Validated[E, T] {
+	if predicate(value) {
+		return Valid[E](value)
+	}
+	return Invalid[E, T](onFail(value))
+}
+
 // IsValid reports whether the value is valid.
 func (v Validated[E, T]) IsValid() bool {
 	return len(v.errors) == 0
@@ -47,6 +57,38 @@ func (v Validated[E, T]) Errors() []E {
 	return copyErrs
 }
 
+// HasErrors reports whether any errors were accumulated. It is the inverse of
+// IsValid.
+func (v Validated[E, T]) HasErrors() bool {
+	return !v.IsValid()
+}
+
+// FirstError returns the first accumulated error, or None when valid.
+func FirstError[E any, T any](v Validated[E, T]) option.Option[E] {
+	if len(v.errors) == 0 {
+		return option.None[E]()
+	}
+	return option.Some(v.errors[0])
+}
+
+// Fold collapses v into a single value, calling onValid with the value or
+// onInvalid with a copy of the accumulated errors.
+func Fold[E any, T any, R any](v Validated[E, T], onInvalid func([]E) R, onValid func(T) R) R {
+	if v.IsValid() {
+		return onValid(v.value)
+	}
+	return onInvalid(v.Errors())
+}
+
+// ToOption returns Some with the value when valid and None otherwise,
+// discarding any errors.
+func ToOption[E any, T any](v Validated[E, T]) option.Option[T] {
+	if !v.IsValid() {
+		return option.None[T]()
+	}
+	return option.Some(v.value)
+}
+
 // UnsafeValue returns the stored value even when invalid.
 func (v Validated[E, T]) UnsafeValue() T {
 	return v.value
@@ -60,6 +102,46 @@ func Map[E any, A any, B any](v Validated[E, A], fn func(A) B) Validated[E, B] {
 	return Valid[E, B](fn(v.value))
 }
 
+// FlatMap runs the dependent validation fn when v is valid and otherwise
+// returns v's errors unchanged. Unlike the applicative Zip, Map2, and Ap, which
+// accumulate errors from independent inputs, FlatMap is monadic: it
+// short-circuits because fn cannot run without the prior value, so errors are
+// never accumulated across the boundary.
+func FlatMap[E any, A any, B any](v Validated[E, A], fn func(A) Validated[E, B]) Validated[E, B] {
+	if !v.IsValid() {
+		return Validated[E, B]{errors: v.errors}
+	}
+	return fn(v.value)
+}
+
+// MapErrors transforms every accumulated error with fn, leaving a valid value
+// untouched.
+func MapErrors[E any, F any, T any](v Validated[E, T], fn func(E) F) Validated[F, T] {
+	if v.IsValid() {
+		return Valid[F](v.value)
+	}
+	errs := make([]F, len(v.errors))
+	for i, err := range v.errors {
+		errs[i] = fn(err)
+	}
+	return Validated[F, T]{errors: errs}
+}
+
+// Bimap transforms the value with onOk when valid, or every accumulated error
+// with onErr otherwise.
+func Bimap[E any, F any, A any, B any](v Validated[E, A], onErr func(E) F, onOk func(A) B) Validated[F, B] {
+	return Map(MapErrors(v, onErr), onOk)
+}
+
+// Ensure turns a valid v into an Invalid carrying onFail(value) when predicate
+// fails. Invalid inputs are returned unchanged.
+func Ensure[E any, T any](v Validated[E, T], predicate func(T) bool, onFail func(T) E) Validated[E, T] {
+	if !v.IsValid() {
+		return v
+	}
+	return FromPredicate(v.value, predicate, onFail)
+}
+
 // Zip combines two Validated values, accumulating errors from both sides.
 func Zip[E any, A any, B any](a Validated[E, A], b Validated[E, B]) Validated[E, result.Tuple2[A, B]] {
 	if a.IsValid() && b.IsValid() {
@@ -68,6 +150,56 @@ func Zip[E any, A any, B any](a Validated[E, A], b Validated[E, B]) Validated[E,
 	return Validated[E, result.Tuple2[A, B]]{errors: appendErrors(a.errors, b.errors)}
 }
 
+// Map2 applies fn to both values when valid, otherwise accumulates the errors
+// from both sides.
+func Map2[E any, A any, B any, C any](a Validated[E, A], b Validated[E, B], fn func(A, B) C) Validated[E, C] {
+	if a.IsValid() && b.IsValid() {
+		return Valid[E](fn(a.value, b.value))
+	}
+	return Validated[E, C]{errors: appendErrors(appendErrors(nil, a.errors), b.errors)}
+}
+
+// Map3 applies fn to all three values when valid, otherwise accumulates the
+// errors from every side in argument order.
+func Map3[E any, A any, B any, C any, D any](
+	a Validated[E, A],
+	b Validated[E, B],
+	c Validated[E, C],
+	fn func(A, B, C) D,
+) Validated[E, D] {
+	if a.IsValid() && b.IsValid() && c.IsValid() {
+		return Valid[E](fn(a.value, b.value, c.value))
+	}
+	return Validated[E, D]{errors: appendErrors(appendErrors(appendErrors(nil, a.errors), b.errors), c.errors)}
+}
+
+// Ap applies the function carried by vf to the value carried by va when both
+// are valid, otherwise accumulates function errors followed by argument errors.
+// Chaining Ap over a curried constructor validates every field at once.
+func Ap[E any, A any, B any](vf Validated[E, func(A) B], va Validated[E, A]) Validated[E, B] {
+	return Map2(vf, va, func(fn func(A) B, a A) B { return fn(a) })
+}
+
+// Or returns a when valid, otherwise b when valid, otherwise an Invalid
+// accumulating the errors of both.
+func Or[E any, T any](a, b Validated[E, T]) Validated[E, T] {
+	return FirstValid(a, b)
+}
+
+// FirstValid returns the first valid alternative. When every alternative is
+// invalid it accumulates all their errors in order. Called without
+// alternatives it returns the zero Validated, which is valid.
+func FirstValid[E any, T any](vs ...Validated[E, T]) Validated[E, T] {
+	var errs []E
+	for _, v := range vs {
+		if v.IsValid() {
+			return v
+		}
+		errs = appendErrors(errs, v.errors)
+	}
+	return Validated[E, T]{errors: errs}
+}
+
 // Sequence collapses a slice of Validated values, returning the first invalid
 // state with accumulated errors or a slice of values when all succeeded.
 func Sequence[E any, T any](items []Validated[E, T]) Validated[E, []T] {
@@ -89,6 +221,22 @@ func Sequence[E any, T any](items []Validated[E, T]) Validated[E, []T] {
 	return Valid[E, []T](values)
 }
 
+// Collect returns every valid value and every accumulated error separately,
+// never short-circuiting, so callers can keep salvageable data while reporting
+// all problems.
+func Collect[E any, T any](items []Validated[E, T]) ([]T, []E) {
+	values := make([]T, 0, len(items))
+	errs := []E{}
+	for _, item := range items {
+		if item.IsValid() {
+			values = append(values, item.value)
+			continue
+		}
+		errs = appendErrors(errs, item.errors)
+	}
+	return values, errs
+}
+
 // Traverse maps the input slice to Validated values and sequences them.
 func Traverse[E any, A any, B any](items []A, fn func(A) Validated[E, B]) Validated[E, []B] {
 	if len(items) == 0 {
@@ -110,6 +258,14 @@ func Traverse[E any, A any, B any](items []A, fn func(A) Validated[E, B]) Valida
 	return Valid[E, []B](values)
 }
 
+// TraverseResult maps each item through a fallible fn and accumulates every
+// error, returning the values only when all items succeed.
+func TraverseResult[A any, B any](items []A, fn func(A) result.Result[B]) Validated[error, []B] {
+	return Traverse(items, func(item A) Validated[error, B] {
+		return FromResult(fn(item))
+	})
+}
+
 // FromResult lifts a Result into a Validated using error accumulation semantics.
 func FromResult[T any](res result.Result[T]) Validated[error, T] {
 	if res.IsOk() {
@@ -127,6 +283,32 @@ func ToResult[T any](v Validated[error, T]) result.Result[T] {
 	return result.Err[T](errors.Join(v.errors...))
 }
 
+// FieldError tags a validation error with the name of the field that failed.
+type FieldError struct {
+	Err   error
+	Field string
+}
+
+// Error formats the error as "field: message", or "field: <nil>" when Err is
+// nil.
+func (e FieldError) Error() string {
+	if e.Err == nil {
+		return e.Field + ": <nil>"
+	}
+	return e.Field + ": " + e.Err.Error()
+}
+
+// Unwrap exposes the underlying error to errors.Is and errors.As.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Field tags every accumulated error of v with name so combining fields via
+// Zip or Map2 yields a per-field report.
+func Field[T any](name string, v Validated[error, T]) Validated[FieldError, T] {
+	return MapErrors(v, func(err error) FieldError { return FieldError{Field: name, Err: err} })
+}
+
 func appendErrors[E any](dst []E, src []E) []E {
 	if len(src) == 0 {
 		return dst
@@ -3,6 +3,7 @@ package validated_test
 import (
 	"errors"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/charmingruby/fgp/result"
@@ -71,3 +72,242 @@ func TestResultInterop(t *testing.T) {
 		t.Fatalf("expected error result")
 	}
 }
+
+func TestFirstErrorAndHasErrors(t *testing.T) {
+	valid := validated.Valid[string](1)
+	if valid.HasErrors() || validated.FirstError(valid).IsSome() {
+		t.Fatalf("expected no errors for valid value")
+	}
+	invalid := validated.Invalid[string, int]("name required", "age negative")
+	if !invalid.HasErrors() {
+		t.Fatalf("expected errors for invalid value")
+	}
+	if first := validated.FirstError(invalid); first.GetOrElse("") != "name required" {
+		t.Fatalf("unexpected first error %v", first)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	values, errs := validated.Collect([]validated.Validated[string, int]{
+		validated.Valid[string](1),
+		validated.Invalid[string, int]("bad a", "bad b"),
+		validated.Valid[string](3),
+		validated.Invalid[string, int]("bad c"),
+	})
+	if !reflect.DeepEqual(values, []int{1, 3}) {
+		t.Fatalf("unexpected values %v", values)
+	}
+	if !reflect.DeepEqual(errs, []string{"bad a", "bad b", "bad c"}) {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
+
+func TestMap2Map3(t *testing.T) {
+	sum := validated.Map2(validated.Valid[string](1), validated.Valid[string](2), func(a, b int) int { return a + b })
+	if !sum.IsValid() || sum.UnsafeValue() != 3 {
+		t.Fatalf("expected combined value, got %v", sum.UnsafeValue())
+	}
+	failed := validated.Map2(
+		validated.Invalid[string, int]("bad a"),
+		validated.Invalid[string, string]("bad b"),
+		func(int, string) bool { panic("fn must not run") },
+	)
+	if !reflect.DeepEqual(failed.Errors(), []string{"bad a", "bad b"}) {
+		t.Fatalf("unexpected errors %v", failed.Errors())
+	}
+	joined := validated.Map3(
+		validated.Valid[string]("ana"),
+		validated.Valid[string](30),
+		validated.Valid[string](true),
+		func(name string, age int, active bool) string {
+			return name + ":" + strconv.Itoa(age) + ":" + strconv.FormatBool(active)
+		},
+	)
+	if !joined.IsValid() || joined.UnsafeValue() != "ana:30:true" {
+		t.Fatalf("unexpected map3 value %q", joined.UnsafeValue())
+	}
+	partial := validated.Map3(
+		validated.Invalid[string, string]("name"),
+		validated.Valid[string](30),
+		validated.Invalid[string, bool]("active"),
+		func(string, int, bool) string { return "" },
+	)
+	if !reflect.DeepEqual(partial.Errors(), []string{"name", "active"}) {
+		t.Fatalf("unexpected map3 errors %v", partial.Errors())
+	}
+}
+
+func TestAp(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	newUser := func(name string) func(int) user {
+		return func(age int) user { return user{Name: name, Age: age} }
+	}
+	type field[T any] = validated.Validated[string, T]
+	build := func(name field[string], age field[int]) field[user] {
+		return validated.Ap(validated.Ap(validated.Valid[string](newUser), name), age)
+	}
+	ok := build(validated.Valid[string]("ana"), validated.Valid[string](30))
+	if !ok.IsValid() || ok.UnsafeValue() != (user{Name: "ana", Age: 30}) {
+		t.Fatalf("unexpected built user %v", ok.UnsafeValue())
+	}
+	failed := build(validated.Invalid[string, string]("name required"), validated.Invalid[string, int]("age invalid"))
+	if !reflect.DeepEqual(failed.Errors(), []string{"name required", "age invalid"}) {
+		t.Fatalf("expected function errors before argument errors, got %v", failed.Errors())
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	ordered := func(r result.Tuple2[int, int]) validated.Validated[string, int] {
+		if r.First > r.Second {
+			return validated.Invalid[string, int]("start after end")
+		}
+		return validated.Valid[string](r.Second - r.First)
+	}
+	span := validated.FlatMap(validated.Zip(validated.Valid[string](2), validated.Valid[string](5)), ordered)
+	if !span.IsValid() || span.UnsafeValue() != 3 {
+		t.Fatalf("unexpected span %v", span.UnsafeValue())
+	}
+	reversed := validated.FlatMap(validated.Zip(validated.Valid[string](5), validated.Valid[string](2)), ordered)
+	if !reflect.DeepEqual(reversed.Errors(), []string{"start after end"}) {
+		t.Fatalf("unexpected dependent errors %v", reversed.Errors())
+	}
+	skipped := validated.FlatMap(
+		validated.Zip(validated.Invalid[string, int]("bad start"), validated.Invalid[string, int]("bad end")),
+		func(result.Tuple2[int, int]) validated.Validated[string, int] { panic("fn must not run") },
+	)
+	if !reflect.DeepEqual(skipped.Errors(), []string{"bad start", "bad end"}) {
+		t.Fatalf("expected prior errors unchanged, got %v", skipped.Errors())
+	}
+}
+
+func TestMapErrorsBimap(t *testing.T) {
+	toErr := func(msg string) error { return errors.New(msg) }
+	mapped := validated.MapErrors(validated.Invalid[string, int]("a", "b"), toErr)
+	if errs := mapped.Errors(); len(errs) != 2 || errs[1].Error() != "b" {
+		t.Fatalf("unexpected mapped errors %v", errs)
+	}
+	if res := validated.ToResult(mapped); res.IsOk() {
+		t.Fatalf("expected funnelled errors to surface in result")
+	}
+	kept := validated.MapErrors(validated.Valid[string](7), toErr)
+	if !kept.IsValid() || kept.UnsafeValue() != 7 {
+		t.Fatalf("expected valid value untouched, got %v", kept.UnsafeValue())
+	}
+	both := validated.Bimap(validated.Valid[string](2), toErr, strconv.Itoa)
+	if !both.IsValid() || both.UnsafeValue() != "2" {
+		t.Fatalf("unexpected bimap value %q", both.UnsafeValue())
+	}
+	failed := validated.Bimap(validated.Invalid[string, int]("x"), toErr, strconv.Itoa)
+	if errs := failed.Errors(); len(errs) != 1 || errs[0].Error() != "x" {
+		t.Fatalf("unexpected bimap errors %v", errs)
+	}
+}
+
+func TestFromPredicateEnsure(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+	mustBePositive := func(v int) string { return strconv.Itoa(v) + " is not positive" }
+	if v := validated.FromPredicate(3, positive, mustBePositive); !v.IsValid() || v.UnsafeValue() != 3 {
+		t.Fatalf("expected valid value, got %v", v.Errors())
+	}
+	negative := validated.FromPredicate(-1, positive, mustBePositive)
+	if !reflect.DeepEqual(negative.Errors(), []string{"-1 is not positive"}) {
+		t.Fatalf("unexpected errors %v", negative.Errors())
+	}
+	even := func(v int) bool { return v%2 == 0 }
+	mustBeEven := func(int) string { return "odd" }
+	odd := validated.Ensure(validated.Valid[string](3), even, mustBeEven)
+	if !reflect.DeepEqual(odd.Errors(), []string{"odd"}) {
+		t.Fatalf("expected ensure to add an error, got %v", odd.Errors())
+	}
+	kept := validated.Ensure(validated.Invalid[string, int]("missing"), even, mustBeEven)
+	if !reflect.DeepEqual(kept.Errors(), []string{"missing"}) {
+		t.Fatalf("expected invalid input unchanged, got %v", kept.Errors())
+	}
+}
+
+func TestFoldToOption(t *testing.T) {
+	describe := func(v validated.Validated[string, int]) string {
+		return validated.Fold(v,
+			func(errs []string) string { return "invalid: " + strconv.Itoa(len(errs)) },
+			func(n int) string { return "valid: " + strconv.Itoa(n) },
+		)
+	}
+	if got := describe(validated.Valid[string](4)); got != "valid: 4" {
+		t.Fatalf("unexpected valid fold %q", got)
+	}
+	if got := describe(validated.Invalid[string, int]("a", "b")); got != "invalid: 2" {
+		t.Fatalf("unexpected invalid fold %q", got)
+	}
+	if opt := validated.ToOption(validated.Valid[string](4)); opt.GetOrElse(0) != 4 {
+		t.Fatalf("expected Some for valid input")
+	}
+	if opt := validated.ToOption(validated.Invalid[string, int]("bad")); opt.IsSome() {
+		t.Fatalf("expected None for invalid input")
+	}
+}
+
+func TestOrFirstValid(t *testing.T) {
+	fromEnv := validated.Invalid[string, int]("env missing")
+	fromFile := validated.Valid[string](8080)
+	if port := validated.Or(fromEnv, fromFile); !port.IsValid() || port.UnsafeValue() != 8080 {
+		t.Fatalf("expected fallback value, got %v", port.Errors())
+	}
+	if port := validated.Or(validated.Valid[string](1), fromFile); port.UnsafeValue() != 1 {
+		t.Fatalf("expected first valid value, got %d", port.UnsafeValue())
+	}
+	none := validated.FirstValid(fromEnv, validated.Invalid[string, int]("file missing", "flag missing"))
+	if !reflect.DeepEqual(none.Errors(), []string{"env missing", "file missing", "flag missing"}) {
+		t.Fatalf("expected all failures, got %v", none.Errors())
+	}
+}
+
+func TestField(t *testing.T) {
+	type signup struct {
+		Name  string
+		Email string
+		Age   int
+	}
+	errRequired := errors.New("required")
+	errTooYoung := errors.New("too young")
+	name := validated.Field("name", validated.Invalid[error, string](errRequired))
+	email := validated.Field("email", validated.Valid[error]("ana@example.com"))
+	age := validated.Field("age", validated.Invalid[error, int](errTooYoung))
+	form := validated.Map3(name, email, age, func(n, e string, a int) signup {
+		return signup{Name: n, Email: e, Age: a}
+	})
+	errs := form.Errors()
+	if len(errs) != 2 || errs[0].Field != "name" || errs[1].Field != "age" {
+		t.Fatalf("unexpected field errors %v", errs)
+	}
+	if !errors.Is(errs[1], errTooYoung) || errs[0].Error() != "name: required" {
+		t.Fatalf("unexpected field error details %v", errs)
+	}
+}
+
+func TestFieldNilError(t *testing.T) {
+	errs := validated.Field("x", validated.Invalid[error, int](nil)).Errors()
+	if len(errs) != 1 || errs[0].Error() != "x: <nil>" || errs[0].Unwrap() != nil {
+		t.Fatalf("unexpected nil field error %v", errs)
+	}
+}
+
+func TestTraverseResult(t *testing.T) {
+	errOdd := errors.New("odd")
+	half := func(v int) result.Result[int] {
+		if v%2 != 0 {
+			return result.Err[int](errOdd)
+		}
+		return result.Ok(v / 2)
+	}
+	ok := validated.TraverseResult([]int{2, 4}, half)
+	if !ok.IsValid() || !reflect.DeepEqual(ok.UnsafeValue(), []int{1, 2}) {
+		t.Fatalf("unexpected valid traversal %v", ok.UnsafeValue())
+	}
+	failed := validated.TraverseResult([]int{1, 2, 3, 5}, half)
+	if failed.IsValid() || len(failed.Errors()) != 3 {
+		t.Fatalf("expected three accumulated errors, got %v", failed.Errors())
+	}
+}